@@ -1,15 +1,130 @@
 package optional
 
-import "testing"
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// sliceString is the slice representation that optionals used before they
+// were structs, kept to compare allocations against.
+type sliceString []string
+
+func ofSliceString(value string) sliceString {
+	return sliceString{0: value}
+}
+
+var (
+	sinkString      String
+	sinkSliceString sliceString
+	sinkBool        bool
+	sinkBytes       []byte
+	sinkStr         string
+	sinkErr         error
+)
 
 func BenchmarkEmpty(b *testing.B) {
+	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
-		EmptyString()
+		sinkString = EmptyString()
 	}
 }
 
 func BenchmarkOf(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sinkString = OfString("hello")
+	}
+}
+
+func BenchmarkOfSlice(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sinkSliceString = ofSliceString("hello")
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	b.ReportAllocs()
+	o := OfString("hello")
+	for n := 0; n < b.N; n++ {
+		_, sinkBool = o.Get()
+	}
+}
+
+func BenchmarkEqual(b *testing.B) {
+	b.ReportAllocs()
+	o1 := OfString("hello")
+	o2 := OfString("hello")
 	for n := 0; n < b.N; n++ {
-		OfString("hello")
+		sinkBool = o1 == o2
+	}
+}
+
+// typeBenchmarks are the optionals of each type, with the JSON that they
+// marshal to, for benchmarking their encoding methods.
+var typeBenchmarks = []struct {
+	Name     string
+	Optional interface {
+		json.Marshaler
+		fmt.Stringer
+	}
+	Unmarshal json.Unmarshaler
+	JSON      string
+}{
+	{"Bool", OfBool(true), new(Bool), `true`},
+	{"Byte", OfByte(255), new(Byte), `255`},
+	{"Complex128", OfComplex128(1 + 2i), new(Complex128), `"1+2i"`},
+	{"Complex64", OfComplex64(1 + 2i), new(Complex64), `"1+2i"`},
+	{"Float32", OfFloat32(1.25), new(Float32), `1.25`},
+	{"Float64", OfFloat64(-123.456), new(Float64), `-123.456`},
+	{"Int", OfInt(-123456), new(Int), `-123456`},
+	{"Int16", OfInt16(-12345), new(Int16), `-12345`},
+	{"Int32", OfInt32(-123456), new(Int32), `-123456`},
+	{"Int64", OfInt64(-1234567890), new(Int64), `-1234567890`},
+	{"Int8", OfInt8(-123), new(Int8), `-123`},
+	{"Rune", OfRune('a'), new(Rune), `97`},
+	{"String", OfString("hello"), new(String), `"hello"`},
+	{"Uint", OfUint(123456), new(Uint), `123456`},
+	{"Uint16", OfUint16(12345), new(Uint16), `12345`},
+	{"Uint32", OfUint32(123456), new(Uint32), `123456`},
+	{"Uint64", OfUint64(1234567890), new(Uint64), `1234567890`},
+	{"Uint8", OfUint8(123), new(Uint8), `123`},
+	{"Uintptr", OfUintptr(123456), new(Uintptr), `123456`},
+	{"Time", OfTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)), new(Time), `"2006-01-02T15:04:05Z"`},
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	for _, bm := range typeBenchmarks {
+		b.Run(bm.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				sinkBytes, sinkErr = bm.Optional.MarshalJSON()
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	for _, bm := range typeBenchmarks {
+		data := []byte(bm.JSON)
+		b.Run(bm.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				sinkErr = bm.Unmarshal.UnmarshalJSON(data)
+			}
+		})
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	for _, bm := range typeBenchmarks {
+		b.Run(bm.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				sinkStr = bm.Optional.String()
+			}
+		})
 	}
 }
@@ -0,0 +1,324 @@
+package optional
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+// The functions in this file marshal and unmarshal the builtin bool, number
+// and string types with strconv instead of reflection, for the optionals of
+// those types, such as Int and String. Optionals of all other types use fmt
+// and encoding/json.
+
+// format returns the same string as fmt for builtin bool, number and string
+// types, without reflection. It returns false for all other types, including
+// types defined with those as their underlying type.
+func format[T any](value T) (string, bool) {
+	switch v := interface{}(value).(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int:
+		return strconv.FormatInt(int64(v), 10), true
+	case int8:
+		return strconv.FormatInt(int64(v), 10), true
+	case int16:
+		return strconv.FormatInt(int64(v), 10), true
+	case int32:
+		return strconv.FormatInt(int64(v), 10), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint64:
+		return strconv.FormatUint(v, 10), true
+	case uintptr:
+		return strconv.FormatUint(uint64(v), 10), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON of builtin bool, number and string types to b,
+// the same as encoding/json but without reflection. It returns false for NaN
+// and infinite floats, for strings that are not valid UTF-8, and for all other
+// types, including types defined with those as their underlying type.
+func appendJSON[T any](b []byte, value T) ([]byte, bool) {
+	switch v := interface{}(value).(type) {
+	case string:
+		if !utf8.ValidString(v) {
+			return b, false
+		}
+		return appendQuotedJSON(b, v), true
+	case bool:
+		return strconv.AppendBool(b, v), true
+	case int:
+		return appendIntJSON(b, int64(v)), true
+	case int8:
+		return appendIntJSON(b, int64(v)), true
+	case int16:
+		return appendIntJSON(b, int64(v)), true
+	case int32:
+		return appendIntJSON(b, int64(v)), true
+	case int64:
+		return appendIntJSON(b, v), true
+	case uint:
+		return strconv.AppendUint(b, uint64(v), 10), true
+	case uint8:
+		return strconv.AppendUint(b, uint64(v), 10), true
+	case uint16:
+		return strconv.AppendUint(b, uint64(v), 10), true
+	case uint32:
+		return strconv.AppendUint(b, uint64(v), 10), true
+	case uint64:
+		return strconv.AppendUint(b, v, 10), true
+	case uintptr:
+		return strconv.AppendUint(b, uint64(v), 10), true
+	case float32:
+		return appendFloatJSON(b, float64(v), 32)
+	case float64:
+		return appendFloatJSON(b, v, 64)
+	}
+	return b, false
+}
+
+// appendIntJSON appends the int, growing b first so that a negative int is
+// appended with one allocation instead of one for the sign and one for the
+// digits.
+func appendIntJSON(b []byte, i int64) []byte {
+	return strconv.AppendInt(slices.Grow(b, 20), i, 10)
+}
+
+// appendFloatJSON appends the float the same as encoding/json, which uses
+// exponents only for very small and very large numbers.
+func appendFloatJSON(b []byte, f float64, bits int) ([]byte, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return b, false
+	}
+	verb := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			verb = 'e'
+		}
+	}
+	b = strconv.AppendFloat(b, f, verb, -1, bits)
+	if verb == 'e' {
+		// Shorten exponents such as e-07 to e-7.
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b, true
+}
+
+// appendQuotedJSON appends the valid UTF-8 string quoted the same as
+// encoding/json, which escapes HTML characters, U+2028 and U+2029.
+func appendQuotedJSON(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	b = slices.Grow(b, len(s)+2)
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// parseJSON parses JSON numbers, bools and strings into builtin bool, number
+// and string types, the same as encoding/json but without reflection. It
+// returns false for all other types, including types defined with those as
+// their underlying type, and for JSON that it does not handle, such as
+// strings with escapes and numbers out of range, which are left to
+// encoding/json to unmarshal or to return an error for.
+func parseJSON[T any](data []byte) (value T, ok bool) {
+	switch v := interface{}(&value).(type) {
+	case *string:
+		*v, ok = parseQuotedJSON(data)
+	case *bool:
+		switch string(data) {
+		case "true":
+			*v, ok = true, true
+		case "false":
+			*v, ok = false, true
+		}
+	case *int:
+		var i int64
+		i, ok = parseIntJSON(data, strconv.IntSize)
+		*v = int(i)
+	case *int8:
+		var i int64
+		i, ok = parseIntJSON(data, 8)
+		*v = int8(i)
+	case *int16:
+		var i int64
+		i, ok = parseIntJSON(data, 16)
+		*v = int16(i)
+	case *int32:
+		var i int64
+		i, ok = parseIntJSON(data, 32)
+		*v = int32(i)
+	case *int64:
+		*v, ok = parseIntJSON(data, 64)
+	case *uint:
+		var u uint64
+		u, ok = parseUintJSON(data, strconv.IntSize)
+		*v = uint(u)
+	case *uint8:
+		var u uint64
+		u, ok = parseUintJSON(data, 8)
+		*v = uint8(u)
+	case *uint16:
+		var u uint64
+		u, ok = parseUintJSON(data, 16)
+		*v = uint16(u)
+	case *uint32:
+		var u uint64
+		u, ok = parseUintJSON(data, 32)
+		*v = uint32(u)
+	case *uint64:
+		*v, ok = parseUintJSON(data, 64)
+	case *uintptr:
+		var u uint64
+		u, ok = parseUintJSON(data, strconv.IntSize)
+		*v = uintptr(u)
+	case *float32:
+		var f float64
+		f, ok = parseFloatJSON(data, 32)
+		*v = float32(f)
+	case *float64:
+		*v, ok = parseFloatJSON(data, 64)
+	}
+	return value, ok
+}
+
+// parseQuotedJSON parses a JSON string that has no escapes and is valid
+// UTF-8.
+func parseQuotedJSON(data []byte) (string, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false
+	}
+	data = data[1 : len(data)-1]
+	for _, c := range data {
+		if c < 0x20 || c == '"' || c == '\\' {
+			return "", false
+		}
+	}
+	if !utf8.Valid(data) {
+		return "", false
+	}
+	return string(data), true
+}
+
+func parseIntJSON(data []byte, bits int) (int64, bool) {
+	if !isJSONNumber(data, true) {
+		return 0, false
+	}
+	i, err := strconv.ParseInt(string(data), 10, bits)
+	return i, err == nil
+}
+
+func parseUintJSON(data []byte, bits int) (uint64, bool) {
+	if !isJSONNumber(data, true) {
+		return 0, false
+	}
+	u, err := strconv.ParseUint(string(data), 10, bits)
+	return u, err == nil
+}
+
+func parseFloatJSON(data []byte, bits int) (float64, bool) {
+	if !isJSONNumber(data, false) {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(string(data), bits)
+	return f, err == nil
+}
+
+// isJSONNumber returns true if the data is a JSON number, and if integer is
+// true, one without a fraction or exponent.
+func isJSONNumber(data []byte, integer bool) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(data) && data[i] == '0':
+		i++
+	case i < len(data) && data[i] >= '1' && data[i] <= '9':
+		for i++; i < len(data) && data[i] >= '0' && data[i] <= '9'; i++ {
+		}
+	default:
+		return false
+	}
+	if integer {
+		return i == len(data)
+	}
+	if i < len(data) && data[i] == '.' {
+		i++
+		if i == len(data) || data[i] < '0' || data[i] > '9' {
+			return false
+		}
+		for ; i < len(data) && data[i] >= '0' && data[i] <= '9'; i++ {
+		}
+	}
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		if i == len(data) || data[i] < '0' || data[i] > '9' {
+			return false
+		}
+		for ; i < len(data) && data[i] >= '0' && data[i] <= '9'; i++ {
+		}
+	}
+	return i == len(data)
+}
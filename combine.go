@@ -0,0 +1,60 @@
+package optional
+
+// Coalesce returns the first of the optionals that has a value wrapped by it,
+// or an empty optional if none do. The optionals may be any of the optional
+// types, such as Optional or Int, but must all be the same type.
+func Coalesce[O interface{ IsPresent() bool }](optionals ...O) O {
+	for _, o := range optionals {
+		if o.IsPresent() {
+			return o
+		}
+	}
+	// The zero value of every optional type is empty.
+	var empty O
+	return empty
+}
+
+// Pair holds two values of possibly different types.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Zip returns an optional wrapping a Pair of the values wrapped by the
+// optionals a and b, or an empty optional if either is empty. The optionals
+// may be any of the optional types, and of different types.
+func Zip[T, U any](a interface{ Get() (T, bool) }, b interface{ Get() (U, bool) }) Optional[Pair[T, U]] {
+	first, ok := a.Get()
+	if !ok {
+		return Empty[Pair[T, U]]()
+	}
+	second, ok := b.Get()
+	if !ok {
+		return Empty[Pair[T, U]]()
+	}
+	return Of(Pair[T, U]{First: first, Second: second})
+}
+
+// AllPresent returns true if every one of the optionals has a value wrapped
+// by it. The optionals may be any of the optional types, and of different
+// types. It returns true if there are no optionals.
+func AllPresent(optionals ...interface{ IsPresent() bool }) bool {
+	for _, o := range optionals {
+		if !o.IsPresent() {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyPresent returns true if at least one of the optionals has a value wrapped
+// by it. The optionals may be any of the optional types, and of different
+// types. It returns false if there are no optionals.
+func AnyPresent(optionals ...interface{ IsPresent() bool }) bool {
+	for _, o := range optionals {
+		if o.IsPresent() {
+			return true
+		}
+	}
+	return false
+}
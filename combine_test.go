@@ -0,0 +1,61 @@
+package optional
+
+import "testing"
+
+func TestCoalesce(t *testing.T) {
+	tests := []struct {
+		Optionals        []Int
+		ExpectedOptional Int
+	}{
+		{nil, EmptyInt()},
+		{[]Int{EmptyInt()}, EmptyInt()},
+		{[]Int{EmptyInt(), OfInt(0), OfInt(1)}, OfInt(0)},
+		{[]Int{OfInt(1), OfInt(2)}, OfInt(1)},
+	}
+
+	for _, test := range tests {
+		o := Coalesce(test.Optionals...)
+
+		if o != test.ExpectedOptional {
+			t.Errorf("Coalesce(%#v) got %#v, want %#v", test.Optionals, o, test.ExpectedOptional)
+		}
+	}
+
+	if o := Coalesce(Empty[string](), Of("b")); o != Of("b") {
+		t.Errorf("Coalesce got %#v, want %#v", o, Of("b"))
+	}
+}
+
+func TestZip(t *testing.T) {
+	if o := Zip(OfInt(1), OfString("a")); o != Of(Pair[int, string]{1, "a"}) {
+		t.Errorf("Zip got %#v, want %#v", o, Of(Pair[int, string]{1, "a"}))
+	}
+	if o := Zip(EmptyInt(), OfString("a")); o.IsPresent() {
+		t.Errorf("Zip got %#v, want empty", o)
+	}
+	if o := Zip(OfInt(1), Empty[string]()); o.IsPresent() {
+		t.Errorf("Zip got %#v, want empty", o)
+	}
+}
+
+func TestAllAnyPresent(t *testing.T) {
+	tests := []struct {
+		Optionals          []interface{ IsPresent() bool }
+		ExpectedAllPresent bool
+		ExpectedAnyPresent bool
+	}{
+		{nil, true, false},
+		{[]interface{ IsPresent() bool }{EmptyInt(), EmptyString()}, false, false},
+		{[]interface{ IsPresent() bool }{OfInt(0), EmptyString()}, false, true},
+		{[]interface{ IsPresent() bool }{OfInt(0), OfString(""), Of(false)}, true, true},
+	}
+
+	for _, test := range tests {
+		if allPresent := AllPresent(test.Optionals...); allPresent != test.ExpectedAllPresent {
+			t.Errorf("AllPresent(%#v) got %v, want %v", test.Optionals, allPresent, test.ExpectedAllPresent)
+		}
+		if anyPresent := AnyPresent(test.Optionals...); anyPresent != test.ExpectedAnyPresent {
+			t.Errorf("AnyPresent(%#v) got %v, want %v", test.Optionals, anyPresent, test.ExpectedAnyPresent)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// The errors wrapped by the errors returned when converting the value of a
+// numeric optional to another numeric type, such as by Convert, that cannot
+// represent it.
+var (
+	// ErrOverflow is wrapped when the value is out of the range of the type.
+	ErrOverflow = errors.New("value out of range")
+	// ErrSignLoss is wrapped when the value is negative and the type is
+	// unsigned.
+	ErrSignLoss = errors.New("negative value for unsigned type")
+	// ErrNotInteger is wrapped when the value is a float with a fraction, or
+	// NaN, and the type is an integer.
+	ErrNotInteger = errors.New("value not an integer")
+)
+
+// Number is the numeric types that optionals convert between.
+type Number interface {
+	int | int8 | int16 | int32 | int64 |
+		uint | uint8 | uint16 | uint32 | uint64 | uintptr |
+		float32 | float64
+}
+
+// Convert converts the value wrapped by the optional to the numeric type To,
+// such as with Convert[int32](o), or returns an error if To cannot represent
+// the value, wrapping ErrOverflow if it is out of range, ErrSignLoss if it is
+// negative and To is unsigned, or ErrNotInteger if it has a fraction or is NaN
+// and To is an integer. Integers converted to floats are rounded to the
+// nearest float if they cannot be represented exactly. An empty optional
+// converts to an empty optional.
+func Convert[To, From Number](o Optional[From]) (Optional[To], error) {
+	v, ok := o.Get()
+	if !ok {
+		return Empty[To](), nil
+	}
+	c, err := convertNumber[To](v)
+	if err != nil {
+		return Empty[To](), err
+	}
+	return Of(c), nil
+}
+
+// ConvertSaturating converts the value wrapped by the optional to the numeric
+// type To, such as with ConvertSaturating[int32](o), limiting it to the range
+// of To, truncating any fraction when To is an integer, and converting NaN to
+// zero, instead of returning an error if To cannot represent the value. An
+// empty optional converts to an empty optional.
+func ConvertSaturating[To, From Number](o Optional[From]) Optional[To] {
+	v, ok := o.Get()
+	if !ok {
+		return Empty[To]()
+	}
+	c, _ := convertNumber[To](v)
+	return Of(c)
+}
+
+// convertNumber converts the value to the type To. If To cannot represent
+// the value, the value is saturated, limited to the range of To with any
+// fraction truncated and NaN converted to zero, and an error is returned.
+func convertNumber[To, From Number](v From) (To, error) {
+	c, err := convertNumberSaturating[To](v)
+	if err != nil {
+		return c, fmt.Errorf("optional: cannot convert %v to %T: %w", v, c, err)
+	}
+	return c, nil
+}
+
+// convertNumberSaturating is convertNumber returning the errors unwrapped.
+func convertNumberSaturating[To, From Number](v From) (To, error) {
+	switch f := any(v).(type) {
+	case float32:
+		return convertFloat[To](float64(f))
+	case float64:
+		return convertFloat[To](f)
+	}
+	if v < 0 {
+		return convertInt[To](int64(v))
+	}
+	return convertUint[To](uint64(v))
+}
+
+// convertInt converts a negative integer.
+func convertInt[To Number](i int64) (To, error) {
+	float, unsigned, bits := numberKind[To]()
+	switch {
+	case float:
+		return To(i), nil
+	case unsigned:
+		return 0, ErrSignLoss
+	}
+	if min := int64(-1) << (bits - 1); i < min {
+		return To(min), ErrOverflow
+	}
+	return To(i), nil
+}
+
+// convertUint converts a non-negative integer.
+func convertUint[To Number](u uint64) (To, error) {
+	float, unsigned, bits := numberKind[To]()
+	if float {
+		return To(u), nil
+	}
+	max := uint64(math.MaxUint64) >> (64 - bits)
+	if !unsigned {
+		max >>= 1
+	}
+	if u > max {
+		return To(max), ErrOverflow
+	}
+	return To(u), nil
+}
+
+func convertFloat[To Number](f float64) (To, error) {
+	float, unsigned, bits := numberKind[To]()
+	if float {
+		if bits == 32 && !math.IsInf(f, 0) && math.IsInf(float64(float32(f)), 0) {
+			return To(math.Copysign(math.MaxFloat32, f)), ErrOverflow
+		}
+		return To(f), nil
+	}
+	t := math.Trunc(f)
+	switch {
+	case math.IsNaN(f):
+		return 0, ErrNotInteger
+	case unsigned && t < 0:
+		return 0, ErrSignLoss
+	case unsigned && t >= math.Ldexp(1, bits):
+		return To(uint64(math.MaxUint64) >> (64 - bits)), ErrOverflow
+	case !unsigned && t >= math.Ldexp(1, bits-1):
+		return To(uint64(math.MaxUint64) >> (65 - bits)), ErrOverflow
+	case !unsigned && t < -math.Ldexp(1, bits-1):
+		return To(int64(-1) << (bits - 1)), ErrOverflow
+	case t != f:
+		return To(t), ErrNotInteger
+	}
+	return To(t), nil
+}
+
+// numberKind returns whether the number type T is a float, whether it is an
+// unsigned integer, and its size in bits.
+func numberKind[T Number]() (float, unsigned bool, bits int) {
+	t := reflect.TypeFor[T]()
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		float = true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		unsigned = true
+	}
+	return float, unsigned, t.Bits()
+}
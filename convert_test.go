@@ -0,0 +1,188 @@
+package optional
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Convert        func() (interface{}, error)
+		Saturate       func() interface{}
+		Expected       interface{}
+		ExpectedErr    error
+		ExpectedSat    interface{}
+		ExpectedErrMsg string
+	}{
+		{
+			Name:        "Int64ToInt32",
+			Convert:     func() (interface{}, error) { return Convert[int32](OfInt64(123)) },
+			Saturate:    func() interface{} { return ConvertSaturating[int32](OfInt64(123)) },
+			Expected:    OfInt32(123),
+			ExpectedSat: OfInt32(123),
+		},
+		{
+			Name:           "Int64ToInt32Overflow",
+			Convert:        func() (interface{}, error) { return Convert[int32](OfInt64(math.MaxInt32 + 1)) },
+			Saturate:       func() interface{} { return ConvertSaturating[int32](OfInt64(math.MaxInt32 + 1)) },
+			Expected:       EmptyInt32(),
+			ExpectedErr:    ErrOverflow,
+			ExpectedSat:    OfInt32(math.MaxInt32),
+			ExpectedErrMsg: "optional: cannot convert 2147483648 to int32: value out of range",
+		},
+		{
+			Name:        "Int64ToInt32Underflow",
+			Convert:     func() (interface{}, error) { return Convert[int32](OfInt64(math.MinInt32 - 1)) },
+			Saturate:    func() interface{} { return ConvertSaturating[int32](OfInt64(math.MinInt32 - 1)) },
+			Expected:    EmptyInt32(),
+			ExpectedErr: ErrOverflow,
+			ExpectedSat: OfInt32(math.MinInt32),
+		},
+		{
+			Name:           "Int8ToUint8SignLoss",
+			Convert:        func() (interface{}, error) { return Convert[uint8](OfInt8(-1)) },
+			Saturate:       func() interface{} { return ConvertSaturating[uint8](OfInt8(-1)) },
+			Expected:       EmptyUint8(),
+			ExpectedErr:    ErrSignLoss,
+			ExpectedSat:    OfUint8(0),
+			ExpectedErrMsg: "optional: cannot convert -1 to uint8: negative value for unsigned type",
+		},
+		{
+			Name:        "Uint64ToInt64Overflow",
+			Convert:     func() (interface{}, error) { return Convert[int64](OfUint64(math.MaxUint64)) },
+			Saturate:    func() interface{} { return ConvertSaturating[int64](OfUint64(math.MaxUint64)) },
+			Expected:    EmptyInt64(),
+			ExpectedErr: ErrOverflow,
+			ExpectedSat: OfInt64(math.MaxInt64),
+		},
+		{
+			Name:        "Uint64ToByte",
+			Convert:     func() (interface{}, error) { return Convert[byte](OfUint64(255)) },
+			Saturate:    func() interface{} { return ConvertSaturating[byte](OfUint64(256)) },
+			Expected:    OfByte(255),
+			ExpectedSat: OfByte(255),
+		},
+		{
+			Name:        "Float64ToInt64",
+			Convert:     func() (interface{}, error) { return Convert[int64](OfFloat64(-42)) },
+			Saturate:    func() interface{} { return ConvertSaturating[int64](OfFloat64(-42)) },
+			Expected:    OfInt64(-42),
+			ExpectedSat: OfInt64(-42),
+		},
+		{
+			Name:           "Float64ToInt64NotInteger",
+			Convert:        func() (interface{}, error) { return Convert[int64](OfFloat64(1.5)) },
+			Saturate:       func() interface{} { return ConvertSaturating[int64](OfFloat64(-1.5)) },
+			Expected:       EmptyInt64(),
+			ExpectedErr:    ErrNotInteger,
+			ExpectedSat:    OfInt64(-1),
+			ExpectedErrMsg: "optional: cannot convert 1.5 to int64: value not an integer",
+		},
+		{
+			Name:        "Float64ToInt64NaN",
+			Convert:     func() (interface{}, error) { return Convert[int64](OfFloat64(math.NaN())) },
+			Saturate:    func() interface{} { return ConvertSaturating[int64](OfFloat64(math.NaN())) },
+			Expected:    EmptyInt64(),
+			ExpectedErr: ErrNotInteger,
+			ExpectedSat: OfInt64(0),
+		},
+		{
+			Name:        "Float64ToInt64Overflow",
+			Convert:     func() (interface{}, error) { return Convert[int64](OfFloat64(1 << 63)) },
+			Saturate:    func() interface{} { return ConvertSaturating[int64](OfFloat64(1 << 63)) },
+			Expected:    EmptyInt64(),
+			ExpectedErr: ErrOverflow,
+			ExpectedSat: OfInt64(math.MaxInt64),
+		},
+		{
+			Name:        "Float64ToInt64Min",
+			Convert:     func() (interface{}, error) { return Convert[int64](OfFloat64(-1 << 63)) },
+			Saturate:    func() interface{} { return ConvertSaturating[int64](OfFloat64(math.Inf(-1))) },
+			Expected:    OfInt64(math.MinInt64),
+			ExpectedSat: OfInt64(math.MinInt64),
+		},
+		{
+			Name:        "Float64ToUint64Overflow",
+			Convert:     func() (interface{}, error) { return Convert[uint64](OfFloat64(1 << 64)) },
+			Saturate:    func() interface{} { return ConvertSaturating[uint64](OfFloat64(math.Inf(1))) },
+			Expected:    EmptyUint64(),
+			ExpectedErr: ErrOverflow,
+			ExpectedSat: OfUint64(math.MaxUint64),
+		},
+		{
+			Name:        "Float32ToUint16SignLoss",
+			Convert:     func() (interface{}, error) { return Convert[uint16](OfFloat32(-2)) },
+			Saturate:    func() interface{} { return ConvertSaturating[uint16](OfFloat32(-2.5)) },
+			Expected:    EmptyUint16(),
+			ExpectedErr: ErrSignLoss,
+			ExpectedSat: OfUint16(0),
+		},
+		{
+			Name:        "Float32ToUint16NegativeFraction",
+			Convert:     func() (interface{}, error) { return Convert[uint16](OfFloat32(-0.5)) },
+			Saturate:    func() interface{} { return ConvertSaturating[uint16](OfFloat32(-0.5)) },
+			Expected:    EmptyUint16(),
+			ExpectedErr: ErrNotInteger,
+			ExpectedSat: OfUint16(0),
+		},
+		{
+			Name:        "Float64ToFloat32Overflow",
+			Convert:     func() (interface{}, error) { return Convert[float32](OfFloat64(math.MaxFloat64)) },
+			Saturate:    func() interface{} { return ConvertSaturating[float32](OfFloat64(-math.MaxFloat64)) },
+			Expected:    EmptyFloat32(),
+			ExpectedErr: ErrOverflow,
+			ExpectedSat: OfFloat32(-math.MaxFloat32),
+		},
+		{
+			Name:        "Float64ToFloat32Inf",
+			Convert:     func() (interface{}, error) { return Convert[float32](OfFloat64(math.Inf(1))) },
+			Saturate:    func() interface{} { return ConvertSaturating[float32](OfFloat64(0.1)) },
+			Expected:    OfFloat32(float32(math.Inf(1))),
+			ExpectedSat: OfFloat32(0.1),
+		},
+		{
+			Name:        "Empty",
+			Convert:     func() (interface{}, error) { return Convert[int8](EmptyFloat64()) },
+			Saturate:    func() interface{} { return ConvertSaturating[uint](EmptyInt64()) },
+			Expected:    EmptyInt8(),
+			ExpectedSat: EmptyUint(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			o, err := test.Convert()
+			if o != test.Expected || !errors.Is(err, test.ExpectedErr) || (err == nil) != (test.ExpectedErr == nil) {
+				t.Errorf("got %#v, %v, want %#v, %v", o, err, test.Expected, test.ExpectedErr)
+			}
+			if test.ExpectedErrMsg != "" && (err == nil || err.Error() != test.ExpectedErrMsg) {
+				t.Errorf("got error %v, want %q", err, test.ExpectedErrMsg)
+			}
+			if o := test.Saturate(); o != test.ExpectedSat {
+				t.Errorf("saturating got %#v, want %#v", o, test.ExpectedSat)
+			}
+		})
+	}
+}
+
+func TestConvertLossless(t *testing.T) {
+	tests := []struct {
+		Convert  func() (interface{}, error)
+		Expected interface{}
+	}{
+		{func() (interface{}, error) { return Convert[int64](OfInt32(-5)) }, OfInt64(-5)},
+		{func() (interface{}, error) { return Convert[int](OfUint8(200)) }, OfInt(200)},
+		{func() (interface{}, error) { return Convert[int32](OfRune('a')) }, OfInt32('a')},
+		{func() (interface{}, error) { return Convert[float64](OfInt64(math.MaxInt64)) }, OfFloat64(1 << 63)},
+		{func() (interface{}, error) { return Convert[float64](EmptyFloat32()) }, EmptyFloat64()},
+	}
+
+	for _, test := range tests {
+		o, err := test.Convert()
+		if o != test.Expected || err != nil {
+			t.Errorf("got %#v, %v, want %#v, nil", o, err, test.Expected)
+		}
+	}
+}
@@ -1,5 +1,5 @@
 /*
-Package optional exports types that wrap the builtin types (int, bool, etc) to represent the lack of value. The types guarantee safety by requiring the developer to unwrap them to get to the inner value. This prevents a nil value being operated on. Optionals marshal to XML and JSON like their underlying type, and omitempty works just like their wrapped type would with a pointer, but without the use of pointers.
+Package optional exports types that wrap the builtin types (int, bool, etc) to represent the lack of value. The types guarantee safety by requiring the developer to unwrap them to get to the inner value. This prevents a nil value being operated on. Optionals marshal to XML and JSON like their underlying type, and omit when empty just like their wrapped type would with a pointer, but without the use of pointers. Optionals are comparable values and wrapping a value does not allocate.
 
 These types are an alternative to using pointers, zero values, or similar null wrapper packages. Unlike similar solutions these will omit correctly from XML and JSON without the use of pointers and the compiler will ensure their value is not used when empty.
 
@@ -12,6 +12,12 @@ Wrap a pointer in an optional:
 	var i *int = ...
 	o := optional.OfIntPtr(i)
 
+Convert it back to a pointer:
+
+	p := o.Ptr() // returns nil if o is empty
+
+Structs with pointer fields, such as those of SDKs and generated API clients, can be copied to and from structs with optional fields using FromPointers and ToPointers.
+
 Unwrap it safely:
 
 	o.If(func(i int) {
@@ -33,12 +39,60 @@ Or get it's value with a fallback to a default:
 		return 100
 	})
 
-XML and JSON are supported out of the box. Use `omitempty` to omit the field when the optional is empty:
+Or get it's value or an error:
+
+	_, err := o.GetOrError(errors.New("missing")) // returns the error if empty
+
+	_, err := o.ElseErr(func() error {
+		// called if o is empty
+		return errors.New("missing")
+	})
+
+	_ := o.MustGet() // panics if empty
+
+	_ := o.Expect("o is required") // panics with the message if empty
+
+The errors returned when no error is given, and the errors panicked, wrap ErrEmpty and can be checked with errors.Is.
+
+Or derive other optionals from it, which are empty if it is empty:
+
+	_ := o.Map(func(i int) int { return i * 2 })
+
+	_ := o.Filter(func(i int) bool { return i > 0 })
+
+	_ := optional.Map(o, strconv.Itoa) // an Optional[string]
+
+	_ := optional.FlatMap(o, func(i int) optional.String {
+		// called if o is not empty
+		return optional.OfString(strconv.Itoa(i))
+	})
+
+Or combine it with other optionals:
+
+	_ := o.Or(optional.OfInt(100)) // returns o, or the other optional if o is empty
+
+	_ := optional.Coalesce(o, p, q) // returns the first optional that is not empty
+
+	_ := optional.Zip(o, s) // an Optional[Pair[int, string]] if both are not empty
+
+	_ := optional.AllPresent(o, s) // true if no optional is empty
+
+	_ := optional.AnyPresent(o, s) // true if any optional is not empty
+
+Or convert it to another numeric optional, with an error if the value cannot be represented:
+
+	_, err := optional.Convert[int8](o) // returns an error if o is out of the range of int8
+
+	_ := optional.ConvertSaturating[int8](o) // limits o to the range of int8
+
+The errors wrap ErrOverflow, ErrSignLoss, or ErrNotInteger, for values out of range, negative values converted to unsigned types, and floats with a fraction converted to integers.
+
+XML and JSON are supported out of the box. Empty optionals marshal to JSON as null and are not marshaled to XML as elements or attributes, the same as nil pointers. Use `omitzero` to omit the JSON field when the optional is empty:
 
 	s := struct {
-		Int1 optional.Int `json:"int1,omitempty"`
-		Int2 optional.Int `json:"int2,omitempty"`
-		Int3 optional.Int `json:"int3,omitempty"`
+		Int1 optional.Int `json:"int1,omitzero"`
+		Int2 optional.Int `json:"int2,omitzero"`
+		Int3 optional.Int `json:"int3,omitzero"`
 	}{
 		Int1: optional.EmptyInt(),
 		Int2: optional.OfInt(1000),
@@ -49,6 +103,53 @@ XML and JSON are supported out of the box. Use `omitempty` to omit the field whe
 
 	// output = {"int2":1000}
 
+Optionals were previously slices, and this is a breaking change for code that relied on that. The `omitempty` JSON struct tag option no longer omits empty optionals, because they are structs, so use `omitzero` instead. Empty optionals in XML fields are no longer marshaled as elements with the zero value. The named types, such as Int and String, are now aliases of Optional, so Rune and Int32 are the same type, as are Byte and Uint8, and a type switch with cases for both no longer compiles. Formatting with `%T` and error messages show the type as `optional.Optional[int]` instead of `optional.Int`. Conversions to database/sql Null types are functions instead of methods, such as `ToNullString(o)` instead of `o.ToNull()`, and conversions between numeric optionals use Convert and ConvertSaturating instead of methods, such as `Convert[int32](o)` instead of `o.ToInt32()`.
+
+When built with GOEXPERIMENT=jsonv2, the types in this package also implement the encoding/json/v2 MarshalerTo and UnmarshalerFrom interfaces, so that they are encoded without an intermediate buffer.
+
+The `json:",string"` struct tag option has no effect on optionals. Wrap a field in Quoted instead, such as Quoted[int64] or Quoted[bool], to marshal numbers and bools to JSON as strings.
+
+Unmarshaling JSON is strict. Wrap a field in Lenient, such as Lenient[int], to also unmarshal its number or bool from a JSON string, such as "42" or "true", and an empty JSON string into an empty optional.
+
+JSON does not support NaN and infinite floats, and marshaling them returns an error. Wrap a field in NonFiniteNull or NonFiniteString, such as NonFiniteNull[float64], to marshal them as null, or as the strings "NaN", "Infinity" and "-Infinity", which also unmarshal back into the same values.
+
+Times marshal in the RFC 3339 form by default. Use UnixTime, UnixMilliTime, or LayoutTime with a TimeLayout, such as DateLayout, to marshal times in other forms.
+
+Runes marshal as the number of their code point. Use RuneChar to marshal them as one character strings.
+
+Complex numbers, which JSON and XML do not support, are marshaled as strings, such as "1+2i".
+
+Elements with the attribute xsi:nil="true" unmarshal into empty optionals. Wrap a field in XMLNil, such as XMLNil[int], to also marshal an empty optional that way.
+
+Optionals also implement encoding.TextMarshaler and encoding.TextUnmarshaler, using the same text forms as strconv and time.Time, so they can be used with text based encodings. Optionals of strings and times, such as String and Time, can be used as JSON map keys.
+
+Optionals implement MarshalYAML and UnmarshalYAML with the signatures that YAML libraries such as gopkg.in/yaml.v2 and gopkg.in/yaml.v3 use, without this package importing them. Empty optionals marshal to YAML as null, a YAML null unmarshals into an empty optional, and the `omitempty` YAML struct tag option omits empty optionals.
+
+Optionals implement encoding.BinaryMarshaler, encoding.BinaryAppender and encoding.BinaryUnmarshaler, which encoding/gob also uses. The binary form is a byte for whether a value is present, which also versions the form, followed by the value: varints for integers, fixed-width little endian for floats and complex numbers, the bytes of strings, the type's own binary form for types that have one, such as time.Time, and encoding/gob for other types. Nullables implement them too, with a byte for whether the nullable is set followed by the binary form of its optional.
+
+Optionals implement sql.Scanner and driver.Valuer so that they can be used directly with database/sql. SQL NULL maps to an empty optional. Times also scan from strings in the RFC 3339 form, and uintptrs are passed to drivers as int64. Optionals also convert to and from the database/sql Null types, such as with OfNullString and ToNullString.
+
+Nullables
+
+For partial updates where a field that was not sent must be distinguished from a field explicitly set to null, use the nullable types, such as NullableInt:
+
+	var n optional.NullableInt
+	n.IsSet()  // false if the field was not present
+	n.IsNull() // true if the field was null
+	n.Get()    // the value, if the field was set to a value
+
+Nullables implement the same interfaces as optionals. Null and not set nullables both marshal to no XML attribute, empty text, and SQL NULL, which unmarshal as null, or as not set for a missing attribute.
+
+Generics
+
+The Optional type wraps a value of any type, without the need to generate code:
+
+	o := optional.Of(MyType{})
+	e := optional.Empty[MyType]()
+	p := optional.OfPtr(&MyType{})
+
+The named types, such as Int and Time, are aliases of Optional, such as Optional[int] and Optional[time.Time], and are kept for compatibility. They have the same methods as Optional, and they can be used with the functions that take an Optional.
+
 Templates
 
 Use the Optional template for your own types by installing gotemplate.
@@ -59,6 +160,12 @@ Then add a `go generate` comment for your type to any `.go` file in your package
 
 	//go:generate gotemplate "4d63.com/optional/template" OptionalMyType(MyType)
 
+To also generate the encoding/json/v2 methods, which are in a separate file that is only built with GOEXPERIMENT=jsonv2, add a second comment after it.
+
+	//go:generate go run 4d63.com/optional/template/jsonv2gen OptionalMyType
+
+The generated type imports this package, and its errors wrap ErrEmpty. The template also generates an XMLNil type for it, the same as XMLNil in this package.
+
 Examples
 
 See the examples for more approaches to use.
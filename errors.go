@@ -0,0 +1,8 @@
+package optional
+
+import "errors"
+
+// ErrEmpty is wrapped by the errors that optionals return and panic with when
+// they have no value wrapped, such as from GetOrError and MustGet, so that
+// those errors can be checked with errors.Is.
+var ErrEmpty = errors.New("optional: no value wrapped")
@@ -0,0 +1,47 @@
+package optional
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrEmpty(t *testing.T) {
+	tests := []struct {
+		Func            func()
+		ExpectedMessage string
+	}{
+		{func() { EmptyInt().MustGet() }, "optional: no value wrapped by optional.Optional[int]"},
+		{func() { EmptyTime().Expect("time") }, "time: optional: no value wrapped by optional.Optional[time.Time]"},
+		{func() { Empty[int]().MustGet() }, "optional: no value wrapped by optional.Optional[int]"},
+		{func() { Empty[string]().Expect("name") }, "name: optional: no value wrapped by optional.Optional[string]"},
+	}
+
+	for _, test := range tests {
+		err := recoverError(test.Func)
+		if !errors.Is(err, ErrEmpty) || err.Error() != test.ExpectedMessage {
+			t.Errorf("panic got %v, want %q wrapping ErrEmpty", err, test.ExpectedMessage)
+		}
+	}
+
+	_, err1 := EmptyBool().GetOrError(nil)
+	_, err2 := EmptyString().ElseErr(func() error { return nil })
+	_, err3 := EmptyUintptr().GetOrError(nil)
+	_, err4 := Empty[float64]().GetOrError(nil)
+	for _, err := range []error{err1, err2, err3, err4} {
+		if !errors.Is(err, ErrEmpty) {
+			t.Errorf("got %v, want error wrapping ErrEmpty", err)
+		}
+	}
+
+	if v, err := OfInt(1).GetOrError(ErrEmpty); v != 1 || err != nil {
+		t.Errorf("GetOrError got %v, %v, want 1, nil", v, err)
+	}
+}
+
+func recoverError(f func()) (err error) {
+	defer func() {
+		err, _ = recover().(error)
+	}()
+	f()
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:build goexperiment.jsonv2
+
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"4d63.com/optional"
+)
+
+func Example_textMapKey() {
+	m := map[optional.Int]string{
+		optional.OfInt(1): "one",
+		optional.OfInt(2): "two",
+	}
+
+	output, _ := json.Marshal(m)
+	fmt.Println(string(output))
+
+	var m2 map[optional.Int]string
+	json.Unmarshal(output, &m2)
+	fmt.Println(m2[optional.OfInt(1)], m2[optional.OfInt(2)])
+
+	// Output:
+	// {"1":"one","2":"two"}
+	// one two
+}
@@ -1,9 +1,14 @@
 package optional_test
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"4d63.com/optional"
@@ -111,24 +116,339 @@ func Example_elseFunc() {
 	// 1001
 }
 
-func Example_jsonMarshalOmitEmpty() {
+func Example_generic() {
+	type Point struct{ X, Y int }
+	values := []optional.Optional[Point]{
+		optional.Empty[Point](),
+		optional.Of(Point{1, 2}),
+		optional.OfPtr((*Point)(nil)),
+		optional.OfPtr(&Point{3, 4}),
+	}
+
+	for _, v := range values {
+		v.If(func(p Point) {
+			fmt.Println(p.X, p.Y)
+		})
+	}
+
+	// Output:
+	// 1 2
+	// 3 4
+}
+
+func Example_errEmpty() {
+	port := optional.EmptyInt()
+
+	_, err := port.GetOrError(nil)
+	fmt.Println(err, errors.Is(err, optional.ErrEmpty))
+
+	_, err = port.ElseErr(func() error { return errors.New("port is required") })
+	fmt.Println(err)
+
+	defer func() {
+		fmt.Println(recover())
+	}()
+	port.Expect("port")
+
+	// Output:
+	// optional: no value wrapped by optional.Optional[int] true
+	// port is required
+	// port: optional: no value wrapped by optional.Optional[int]
+}
+
+func Example_pointers() {
+	type request struct {
+		Name *string
+		Age  *int
+	}
+	type user struct {
+		Name optional.String
+		Age  optional.Int
+	}
+
+	name := "Jo"
+	var u user
+	optional.FromPointers(&u, request{Name: &name})
+	fmt.Println(u.Name, u.Age.IsPresent())
+
+	var r request
+	optional.ToPointers(&r, user{Age: optional.OfInt(30)})
+	fmt.Println(r.Name, *r.Age)
+
+	// Output:
+	// Jo false
+	// <nil> 30
+}
+
+func Example_convert() {
+	size := optional.OfInt64(300)
+
+	_, err := optional.Convert[uint8](size)
+	fmt.Println(err, errors.Is(err, optional.ErrOverflow))
+	fmt.Println(optional.ConvertSaturating[uint8](size))
+
+	ratio := optional.OfFloat64(2.5)
+
+	_, err = optional.Convert[int](ratio)
+	fmt.Println(err)
+	fmt.Println(optional.ConvertSaturating[int](ratio))
+
+	f, _ := optional.Convert[float64](size)
+	fmt.Println(f)
+
+	// Output:
+	// optional: cannot convert 300 to uint8: value out of range true
+	// 255
+	// optional: cannot convert 2.5 to int: value not an integer
+	// 2
+	// 300
+}
+
+func Example_map() {
+	port := optional.OfString("8080")
+
+	n := optional.FlatMap(port, func(s string) optional.Int {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return optional.EmptyInt()
+		}
+		return optional.OfInt(i)
+	})
+	fmt.Println(n.Filter(func(i int) bool { return i > 1024 }))
+
+	addr := optional.Map(n, func(i int) string { return fmt.Sprintf(":%d", i) })
+	fmt.Println(addr)
+
+	// Output:
+	// 8080
+	// :8080
+}
+
+func Example_combine() {
+	flag := optional.EmptyString()
+	env := optional.OfString("localhost")
+	host := optional.Coalesce(flag, env).Or(optional.OfString("0.0.0.0"))
+	port := optional.OfInt(8080)
+
+	fmt.Println(optional.AllPresent(host, port), optional.AnyPresent(flag))
+	if addr, ok := optional.Zip(host, port).Get(); ok {
+		fmt.Printf("%s:%d\n", addr.First, addr.Second)
+	}
+
+	// Output:
+	// true false
+	// localhost:8080
+}
+
+func Example_nullable() {
+	type patch struct {
+		Age  optional.NullableInt    `json:"age"`
+		Name optional.NullableString `json:"name"`
+		City optional.NullableString `json:"city"`
+	}
+
+	var p patch
+	json.Unmarshal([]byte(`{"age": 42, "name": null}`), &p)
+	fmt.Println("Age:", p.Age.IsSet(), p.Age.IsNull(), p.Age)
+	fmt.Println("Name:", p.Name.IsSet(), p.Name.IsNull())
+	fmt.Println("City:", p.City.IsSet(), p.City.IsNull())
+
+	// Output:
+	// Age: true false 42
+	// Name: true true
+	// City: false false
+}
+
+func Example_textMapKeyString() {
+	m := map[optional.String]int{
+		optional.OfString("one"): 1,
+		optional.OfString("two"): 2,
+	}
+
+	output, _ := json.Marshal(m)
+	fmt.Println(string(output))
+
+	var m2 map[optional.String]int
+	json.Unmarshal(output, &m2)
+	fmt.Println(m2[optional.OfString("one")], m2[optional.OfString("two")])
+
+	// Output:
+	// {"one":1,"two":2}
+	// 1 2
+}
+
+func Example_complex() {
 	s := struct {
-		Bool    optional.Bool    `json:"bool,omitempty"`
-		Byte    optional.Byte    `json:"byte,omitempty"`
-		Float32 optional.Float32 `json:"float32,omitempty"`
-		Float64 optional.Float64 `json:"float64,omitempty"`
-		Int16   optional.Int16   `json:"int16,omitempty"`
-		Int32   optional.Int32   `json:"int32,omitempty"`
-		Int64   optional.Int64   `json:"int64,omitempty"`
-		Int     optional.Int     `json:"int,omitempty"`
-		Rune    optional.Rune    `json:"rune,omitempty"`
-		String  optional.String  `json:"string,omitempty"`
-		Time    optional.Time    `json:"time,omitempty"`
-		Uint16  optional.Uint16  `json:"uint16,omitempty"`
-		Uint32  optional.Uint32  `json:"uint32,omitempty"`
-		Uint64  optional.Uint64  `json:"uint64,omitempty"`
-		Uint    optional.Uint    `json:"uint,omitempty"`
-		Uintptr optional.Uintptr `json:"uintptr,omitempty"`
+		XMLName    xml.Name            `json:"-" xml:"s"`
+		Complex64  optional.Complex64  `json:"complex64" xml:"complex64"`
+		Complex128 optional.Complex128 `json:"complex128" xml:"complex128"`
+	}{
+		Complex64:  optional.OfComplex64(1 + 2i),
+		Complex128: optional.OfComplex128(-1.5 - 0.5i),
+	}
+
+	output, _ := json.Marshal(s)
+	fmt.Println(string(output))
+	s.Complex64, s.Complex128 = optional.EmptyComplex64(), optional.EmptyComplex128()
+	json.Unmarshal(output, &s)
+	fmt.Println(s.Complex64, s.Complex128)
+
+	output, _ = xml.Marshal(s)
+	fmt.Println(string(output))
+	s.Complex64, s.Complex128 = optional.EmptyComplex64(), optional.EmptyComplex128()
+	xml.Unmarshal(output, &s)
+	fmt.Println(s.Complex64, s.Complex128)
+
+	// Output:
+	// {"complex64":"1+2i","complex128":"-1.5-0.5i"}
+	// (1+2i) (-1.5-0.5i)
+	// <s><complex64>1+2i</complex64><complex128>-1.5-0.5i</complex128></s>
+	// (1+2i) (-1.5-0.5i)
+}
+
+func Example_jsonString() {
+	s := struct {
+		ID    optional.Quoted[int64] `json:"id"`
+		Admin optional.Quoted[bool]  `json:"admin"`
+		Score optional.Quoted[int64] `json:"score"`
+	}{
+		ID:    optional.Quoted[int64]{optional.OfInt64(9007199254740993)},
+		Admin: optional.Quoted[bool]{optional.OfBool(true)},
+		Score: optional.Quoted[int64]{optional.EmptyInt64()},
+	}
+
+	output, _ := json.Marshal(s)
+	fmt.Println(string(output))
+
+	json.Unmarshal([]byte(`{"id": 42, "admin": "false", "score": ""}`), &s)
+	fmt.Println(s.ID, s.Admin, s.Score.IsPresent())
+
+	// Output:
+	// {"id":"9007199254740993","admin":"true","score":null}
+	// 42 false false
+}
+
+func Example_jsonLenient() {
+	s := struct {
+		Int  optional.Lenient[int]       `json:"int"`
+		Bool optional.Lenient[bool]      `json:"bool"`
+		Time optional.Lenient[time.Time] `json:"time"`
+	}{}
+
+	json.Unmarshal([]byte(`{"int": "42", "bool": "true", "time": ""}`), &s)
+	fmt.Println("Int:", s.Int.IsPresent(), s.Int)
+	fmt.Println("Bool:", s.Bool.IsPresent(), s.Bool)
+	fmt.Println("Time:", s.Time.IsPresent())
+
+	// Output:
+	// Int: true 42
+	// Bool: true true
+	// Time: false
+}
+
+func Example_jsonNonFinite() {
+	s := struct {
+		Float1 optional.NonFiniteString[float64] `json:"float1"`
+		Float2 optional.NonFiniteString[float64] `json:"float2"`
+		Float3 optional.NonFiniteNull[float64]   `json:"float3"`
+	}{
+		Float1: optional.NonFiniteString[float64]{optional.OfFloat64(math.Inf(1))},
+		Float2: optional.NonFiniteString[float64]{optional.OfFloat64(2.2)},
+		Float3: optional.NonFiniteNull[float64]{optional.OfFloat64(math.NaN())},
+	}
+
+	output, _ := json.Marshal(s)
+	fmt.Println(string(output))
+
+	// Output:
+	// {"float1":"Infinity","float2":2.2,"float3":null}
+}
+
+func Example_timeLayouts() {
+	t := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	s := struct {
+		Unix    optional.UnixTime                           `json:"unix"`
+		Milli   optional.UnixMilliTime                      `json:"milli"`
+		Date    optional.LayoutTime[optional.DateLayout]    `json:"date"`
+		RFC1123 optional.LayoutTime[optional.RFC1123Layout] `json:"rfc1123"`
+	}{
+		Unix:    optional.UnixTime{Time: optional.OfTime(t)},
+		Milli:   optional.UnixMilliTime{Time: optional.OfTime(t)},
+		Date:    optional.LayoutTime[optional.DateLayout]{Time: optional.OfTime(t)},
+		RFC1123: optional.LayoutTime[optional.RFC1123Layout]{Time: optional.EmptyTime()},
+	}
+
+	output, _ := json.MarshalIndent(s, "", "  ")
+	fmt.Println(string(output))
+
+	// Output:
+	// {
+	//   "unix": 1136214245,
+	//   "milli": 1136214245000,
+	//   "date": "2006-01-02",
+	//   "rfc1123": null
+	// }
+}
+
+func Example_runeChar() {
+	s := struct {
+		Rune optional.Rune     `json:"rune"`
+		Char optional.RuneChar `json:"char"`
+	}{
+		Rune: optional.OfRune('a'),
+		Char: optional.RuneChar{Rune: optional.OfRune('a')},
+	}
+
+	output, _ := json.Marshal(s)
+	fmt.Println(string(output))
+
+	json.Unmarshal([]byte(`{"char": 98}`), &s)
+	fmt.Println(s.Char)
+
+	// Output:
+	// {"rune":97,"char":"a"}
+	// b
+}
+
+func Example_gob() {
+	type s struct {
+		Int    optional.Int
+		String optional.String
+	}
+
+	var buf bytes.Buffer
+	gob.NewEncoder(&buf).Encode(s{Int: optional.EmptyInt(), String: optional.OfString("")})
+
+	var decoded s
+	gob.NewDecoder(&buf).Decode(&decoded)
+	fmt.Println(decoded.Int.IsPresent(), decoded.String.IsPresent())
+
+	data, _ := optional.OfInt(300).MarshalBinary()
+	fmt.Printf("%x\n", data)
+
+	// Output:
+	// false true
+	// 01d804
+}
+
+func Example_jsonMarshalOmitZero() {
+	s := struct {
+		Bool    optional.Bool    `json:"bool,omitzero"`
+		Byte    optional.Byte    `json:"byte,omitzero"`
+		Float32 optional.Float32 `json:"float32,omitzero"`
+		Float64 optional.Float64 `json:"float64,omitzero"`
+		Int16   optional.Int16   `json:"int16,omitzero"`
+		Int32   optional.Int32   `json:"int32,omitzero"`
+		Int64   optional.Int64   `json:"int64,omitzero"`
+		Int     optional.Int     `json:"int,omitzero"`
+		Rune    optional.Rune    `json:"rune,omitzero"`
+		String  optional.String  `json:"string,omitzero"`
+		Time    optional.Time    `json:"time,omitzero"`
+		Uint16  optional.Uint16  `json:"uint16,omitzero"`
+		Uint32  optional.Uint32  `json:"uint32,omitzero"`
+		Uint64  optional.Uint64  `json:"uint64,omitzero"`
+		Uint    optional.Uint    `json:"uint,omitzero"`
+		Uintptr optional.Uintptr `json:"uintptr,omitzero"`
 	}{
 		Bool:    optional.EmptyBool(),
 		Byte:    optional.EmptyByte(),
@@ -197,22 +517,22 @@ func Example_jsonMarshalEmpty() {
 
 	// Output:
 	// {
-	//   "bool": false,
-	//   "byte": 0,
-	//   "float32": 0,
-	//   "float64": 0,
-	//   "int16": 0,
-	//   "int32": 0,
-	//   "int64": 0,
-	//   "int": 0,
-	//   "rune": 0,
-	//   "string": "",
-	//   "time": "0001-01-01T00:00:00Z",
-	//   "uint16": 0,
-	//   "uint32": 0,
-	//   "uint64": 0,
-	//   "uint": 0,
-	//   "uintptr": 0
+	//   "bool": null,
+	//   "byte": null,
+	//   "float32": null,
+	//   "float64": null,
+	//   "int16": null,
+	//   "int32": null,
+	//   "int64": null,
+	//   "int": null,
+	//   "rune": null,
+	//   "string": null,
+	//   "time": null,
+	//   "uint16": null,
+	//   "uint32": null,
+	//   "uint64": null,
+	//   "uint": null,
+	//   "uintptr": null
 	// }
 }
 
@@ -317,6 +637,42 @@ func Example_jsonUnmarshalEmpty() {
 	fmt.Println("Uint:", s.Uint.IsPresent())
 	fmt.Println("Uintptr:", s.Uint.IsPresent())
 
+	x = `{
+  "bool": null,
+  "byte": null,
+  "float32": null,
+  "float64": null,
+  "int16": null,
+  "int32": null,
+  "int64": null,
+  "int": null,
+  "rune": null,
+  "string": null,
+  "time": null,
+  "uint16": null,
+  "uint32": null,
+  "uint64": null,
+  "uint": null,
+  "uintptr": null
+}`
+	json.Unmarshal([]byte(x), &s)
+	fmt.Println("Bool:", s.Bool.IsPresent())
+	fmt.Println("Byte:", s.Byte.IsPresent())
+	fmt.Println("Float32:", s.Float32.IsPresent())
+	fmt.Println("Float64:", s.Float64.IsPresent())
+	fmt.Println("Int16:", s.Int16.IsPresent())
+	fmt.Println("Int32:", s.Int32.IsPresent())
+	fmt.Println("Int64:", s.Int64.IsPresent())
+	fmt.Println("Int:", s.Int.IsPresent())
+	fmt.Println("Rune:", s.Rune.IsPresent())
+	fmt.Println("String:", s.String.IsPresent())
+	fmt.Println("Time:", s.Time.IsPresent())
+	fmt.Println("Uint16:", s.Uint16.IsPresent())
+	fmt.Println("Uint32:", s.Uint32.IsPresent())
+	fmt.Println("Uint64:", s.Uint64.IsPresent())
+	fmt.Println("Uint:", s.Uint.IsPresent())
+	fmt.Println("Uintptr:", s.Uintptr.IsPresent())
+
 	// Output:
 	// Bool: false
 	// Byte: false
@@ -335,6 +691,22 @@ func Example_jsonUnmarshalEmpty() {
 	// Uint64: false
 	// Uint: false
 	// Uintptr: false
+	// Bool: false
+	// Byte: false
+	// Float32: false
+	// Float64: false
+	// Int16: false
+	// Int32: false
+	// Int64: false
+	// Int: false
+	// Rune: false
+	// String: false
+	// Time: false
+	// Uint16: false
+	// Uint32: false
+	// Uint64: false
+	// Uint: false
+	// Uintptr: false
 }
 
 func Example_jsonUnmarshalPresent() {
@@ -501,24 +873,7 @@ func Example_xmlMarshalEmpty() {
 	fmt.Println(string(output))
 
 	// Output:
-	// <s>
-	//   <bool>false</bool>
-	//   <byte>0</byte>
-	//   <float32>0</float32>
-	//   <float64>0</float64>
-	//   <int16>0</int16>
-	//   <int32>0</int32>
-	//   <int64>0</int64>
-	//   <int>0</int>
-	//   <rune>0</rune>
-	//   <string></string>
-	//   <time>0001-01-01T00:00:00Z</time>
-	//   <uint16>0</uint16>
-	//   <uint32>0</uint32>
-	//   <uint64>0</uint64>
-	//   <uint>0</uint>
-	//   <uintptr>0</uintptr>
-	// </s>
+	// <s></s>
 }
 
 func Example_xmlMarshalPresent() {
@@ -721,3 +1076,237 @@ func Example_xmlUnmarshalPresent() {
 	// Uint: true 0
 	// Uintptr: true 0
 }
+
+func Example_xmlNil() {
+	s := struct {
+		XMLName xml.Name             `xml:"s"`
+		Int1    optional.XMLNil[int] `xml:"int1"`
+		Int2    optional.XMLNil[int] `xml:"int2"`
+	}{
+		Int1: optional.XMLNil[int]{optional.EmptyInt()},
+		Int2: optional.XMLNil[int]{optional.OfInt(1000)},
+	}
+
+	output, _ := xml.MarshalIndent(s, "", "  ")
+	fmt.Println(string(output))
+
+	xml.Unmarshal(output, &s)
+	fmt.Println("Int1:", s.Int1.IsPresent())
+	fmt.Println("Int2:", s.Int2.IsPresent(), s.Int2)
+
+	// Output:
+	// <s>
+	//   <int1 xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></int1>
+	//   <int2>1000</int2>
+	// </s>
+	// Int1: false
+	// Int2: true 1000
+}
+
+func Example_xmlMarshalAttrOmitEmpty() {
+	s := struct {
+		XMLName xml.Name         `xml:"s"`
+		Bool    optional.Bool    `xml:"bool,attr,omitempty"`
+		Byte    optional.Byte    `xml:"byte,attr,omitempty"`
+		Float32 optional.Float32 `xml:"float32,attr,omitempty"`
+		Float64 optional.Float64 `xml:"float64,attr,omitempty"`
+		Int16   optional.Int16   `xml:"int16,attr,omitempty"`
+		Int32   optional.Int32   `xml:"int32,attr,omitempty"`
+		Int64   optional.Int64   `xml:"int64,attr,omitempty"`
+		Int     optional.Int     `xml:"int,attr,omitempty"`
+		Rune    optional.Rune    `xml:"rune,attr,omitempty"`
+		String  optional.String  `xml:"string,attr,omitempty"`
+		Time    optional.Time    `xml:"time,attr,omitempty"`
+		Uint16  optional.Uint16  `xml:"uint16,attr,omitempty"`
+		Uint32  optional.Uint32  `xml:"uint32,attr,omitempty"`
+		Uint64  optional.Uint64  `xml:"uint64,attr,omitempty"`
+		Uint    optional.Uint    `xml:"uint,attr,omitempty"`
+		Uintptr optional.Uintptr `xml:"uintptr,attr,omitempty"`
+	}{
+		Bool:    optional.EmptyBool(),
+		Byte:    optional.EmptyByte(),
+		Float32: optional.EmptyFloat32(),
+		Float64: optional.EmptyFloat64(),
+		Int16:   optional.EmptyInt16(),
+		Int32:   optional.EmptyInt32(),
+		Int64:   optional.EmptyInt64(),
+		Int:     optional.EmptyInt(),
+		Rune:    optional.EmptyRune(),
+		String:  optional.EmptyString(),
+		Time:    optional.EmptyTime(),
+		Uint16:  optional.EmptyUint16(),
+		Uint32:  optional.EmptyUint32(),
+		Uint64:  optional.EmptyUint64(),
+		Uint:    optional.EmptyUint(),
+		Uintptr: optional.EmptyUintptr(),
+	}
+
+	output, _ := xml.MarshalIndent(s, "", "  ")
+	fmt.Println(string(output))
+
+	// Output:
+	// <s></s>
+}
+
+func Example_xmlMarshalAttrPresent() {
+	s := struct {
+		XMLName xml.Name         `xml:"s"`
+		Bool    optional.Bool    `xml:"bool,attr"`
+		Byte    optional.Byte    `xml:"byte,attr"`
+		Float32 optional.Float32 `xml:"float32,attr"`
+		Float64 optional.Float64 `xml:"float64,attr"`
+		Int16   optional.Int16   `xml:"int16,attr"`
+		Int32   optional.Int32   `xml:"int32,attr"`
+		Int64   optional.Int64   `xml:"int64,attr"`
+		Int     optional.Int     `xml:"int,attr"`
+		Rune    optional.Rune    `xml:"rune,attr"`
+		String  optional.String  `xml:"string,attr"`
+		Time    optional.Time    `xml:"time,attr"`
+		Uint16  optional.Uint16  `xml:"uint16,attr"`
+		Uint32  optional.Uint32  `xml:"uint32,attr"`
+		Uint64  optional.Uint64  `xml:"uint64,attr"`
+		Uint    optional.Uint    `xml:"uint,attr"`
+		Uintptr optional.Uintptr `xml:"uintptr,attr"`
+	}{
+		Bool:    optional.OfBool(true),
+		Byte:    optional.OfByte(1),
+		Float32: optional.OfFloat32(2.1),
+		Float64: optional.OfFloat64(2.2),
+		Int16:   optional.OfInt16(3),
+		Int32:   optional.OfInt32(4),
+		Int64:   optional.OfInt64(5),
+		Int:     optional.OfInt(6),
+		Rune:    optional.OfRune(7),
+		String:  optional.OfString("string"),
+		Time:    optional.OfTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+		Uint16:  optional.OfUint16(8),
+		Uint32:  optional.OfUint32(9),
+		Uint64:  optional.OfUint64(10),
+		Uint:    optional.OfUint(11),
+		Uintptr: optional.OfUintptr(12),
+	}
+
+	output, _ := xml.MarshalIndent(s, "", "  ")
+	fmt.Println(string(output))
+
+	// Output:
+	// <s bool="true" byte="1" float32="2.1" float64="2.2" int16="3" int32="4" int64="5" int="6" rune="7" string="string" time="2006-01-02T15:04:05Z" uint16="8" uint32="9" uint64="10" uint="11" uintptr="12"></s>
+}
+
+func Example_xmlUnmarshalAttrEmpty() {
+	s := struct {
+		XMLName xml.Name         `xml:"s"`
+		Bool    optional.Bool    `xml:"bool,attr"`
+		Byte    optional.Byte    `xml:"byte,attr"`
+		Float32 optional.Float32 `xml:"float32,attr"`
+		Float64 optional.Float64 `xml:"float64,attr"`
+		Int16   optional.Int16   `xml:"int16,attr"`
+		Int32   optional.Int32   `xml:"int32,attr"`
+		Int64   optional.Int64   `xml:"int64,attr"`
+		Int     optional.Int     `xml:"int,attr"`
+		Rune    optional.Rune    `xml:"rune,attr"`
+		String  optional.String  `xml:"string,attr"`
+		Time    optional.Time    `xml:"time,attr"`
+		Uint16  optional.Uint16  `xml:"uint16,attr"`
+		Uint32  optional.Uint32  `xml:"uint32,attr"`
+		Uint64  optional.Uint64  `xml:"uint64,attr"`
+		Uint    optional.Uint    `xml:"uint,attr"`
+		Uintptr optional.Uintptr `xml:"uintptr,attr"`
+	}{}
+
+	x := `<s></s>`
+	xml.Unmarshal([]byte(x), &s)
+	fmt.Println("Bool:", s.Bool.IsPresent())
+	fmt.Println("Byte:", s.Byte.IsPresent())
+	fmt.Println("Float32:", s.Float32.IsPresent())
+	fmt.Println("Float64:", s.Float64.IsPresent())
+	fmt.Println("Int16:", s.Int16.IsPresent())
+	fmt.Println("Int32:", s.Int32.IsPresent())
+	fmt.Println("Int64:", s.Int64.IsPresent())
+	fmt.Println("Int:", s.Int.IsPresent())
+	fmt.Println("Rune:", s.Rune.IsPresent())
+	fmt.Println("String:", s.String.IsPresent())
+	fmt.Println("Time:", s.Time.IsPresent())
+	fmt.Println("Uint16:", s.Uint16.IsPresent())
+	fmt.Println("Uint32:", s.Uint32.IsPresent())
+	fmt.Println("Uint64:", s.Uint64.IsPresent())
+	fmt.Println("Uint:", s.Uint.IsPresent())
+	fmt.Println("Uintptr:", s.Uintptr.IsPresent())
+
+	// Output:
+	// Bool: false
+	// Byte: false
+	// Float32: false
+	// Float64: false
+	// Int16: false
+	// Int32: false
+	// Int64: false
+	// Int: false
+	// Rune: false
+	// String: false
+	// Time: false
+	// Uint16: false
+	// Uint32: false
+	// Uint64: false
+	// Uint: false
+	// Uintptr: false
+}
+
+func Example_xmlUnmarshalAttrPresent() {
+	s := struct {
+		XMLName xml.Name         `xml:"s"`
+		Bool    optional.Bool    `xml:"bool,attr"`
+		Byte    optional.Byte    `xml:"byte,attr"`
+		Float32 optional.Float32 `xml:"float32,attr"`
+		Float64 optional.Float64 `xml:"float64,attr"`
+		Int16   optional.Int16   `xml:"int16,attr"`
+		Int32   optional.Int32   `xml:"int32,attr"`
+		Int64   optional.Int64   `xml:"int64,attr"`
+		Int     optional.Int     `xml:"int,attr"`
+		Rune    optional.Rune    `xml:"rune,attr"`
+		String  optional.String  `xml:"string,attr"`
+		Time    optional.Time    `xml:"time,attr"`
+		Uint16  optional.Uint16  `xml:"uint16,attr"`
+		Uint32  optional.Uint32  `xml:"uint32,attr"`
+		Uint64  optional.Uint64  `xml:"uint64,attr"`
+		Uint    optional.Uint    `xml:"uint,attr"`
+		Uintptr optional.Uintptr `xml:"uintptr,attr"`
+	}{}
+
+	x := `<s bool="false" byte="0" float32="0" float64="0" int16="0" int32="0" int64="0" int="0" rune="0" string="string" time="0001-01-01T00:00:00Z" uint16="0" uint32="0" uint64="0" uint="0" uintptr="0"></s>`
+	xml.Unmarshal([]byte(x), &s)
+	fmt.Println("Bool:", s.Bool.IsPresent(), s.Bool)
+	fmt.Println("Byte:", s.Byte.IsPresent(), s.Byte)
+	fmt.Println("Float32:", s.Float32.IsPresent(), s.Float32)
+	fmt.Println("Float64:", s.Float64.IsPresent(), s.Float64)
+	fmt.Println("Int16:", s.Int16.IsPresent(), s.Int16)
+	fmt.Println("Int32:", s.Int32.IsPresent(), s.Int32)
+	fmt.Println("Int64:", s.Int64.IsPresent(), s.Int64)
+	fmt.Println("Int:", s.Int.IsPresent(), s.Int)
+	fmt.Println("Rune:", s.Rune.IsPresent(), s.Rune)
+	fmt.Println("String:", s.String.IsPresent(), s.String)
+	fmt.Println("Time:", s.Time.IsPresent(), s.Time)
+	fmt.Println("Uint16:", s.Uint16.IsPresent(), s.Uint16)
+	fmt.Println("Uint32:", s.Uint32.IsPresent(), s.Uint32)
+	fmt.Println("Uint64:", s.Uint64.IsPresent(), s.Uint64)
+	fmt.Println("Uint:", s.Uint.IsPresent(), s.Uint)
+	fmt.Println("Uintptr:", s.Uintptr.IsPresent(), s.Uintptr)
+
+	// Output:
+	// Bool: true false
+	// Byte: true 0
+	// Float32: true 0
+	// Float64: true 0
+	// Int16: true 0
+	// Int32: true 0
+	// Int64: true 0
+	// Int: true 0
+	// Rune: true 0
+	// String: true string
+	// Time: true 0001-01-01 00:00:00 +0000 UTC
+	// Uint16: true 0
+	// Uint32: true 0
+	// Uint64: true 0
+	// Uint: true 0
+	// Uintptr: true 0
+}
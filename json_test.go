@@ -0,0 +1,130 @@
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestMarshalJSONFast(t *testing.T) {
+	values := []interface{}{
+		true, false,
+		0, -1, math.MaxInt64, math.MinInt64,
+		int8(math.MinInt8), int16(math.MaxInt16), int32(math.MinInt32), int64(math.MaxInt64),
+		uint(math.MaxUint64), uint8(math.MaxUint8), uint16(math.MaxUint16), uint32(math.MaxUint32), uint64(math.MaxUint64), uintptr(42),
+		float32(0.1), float32(1e-7), float32(1e21), float32(-3.4e38), float32(math.SmallestNonzeroFloat32),
+		0.1, -0.0, 1e-6, 1e-7, 123456789.125, 1e20, 1e21, -1e-300, math.MaxFloat64, math.SmallestNonzeroFloat64,
+		"", "hello", `"quoted" \ back`, "<a&b>", "\u2028\u2029", "\b\f\n\r\t\x00\x1f\x7f", "日本語", "  ", "invalid \xff\xfe utf-8", "\xe2\x80",
+	}
+
+	for _, v := range values {
+		var o json.Marshaler
+		switch v := v.(type) {
+		case bool:
+			o = OfBool(v)
+		case int:
+			o = OfInt(v)
+		case int8:
+			o = OfInt8(v)
+		case int16:
+			o = OfInt16(v)
+		case int32:
+			o = OfInt32(v)
+		case int64:
+			o = OfInt64(v)
+		case uint:
+			o = OfUint(v)
+		case uint8:
+			o = OfUint8(v)
+		case uint16:
+			o = OfUint16(v)
+		case uint32:
+			o = OfUint32(v)
+		case uint64:
+			o = OfUint64(v)
+		case uintptr:
+			o = OfUintptr(v)
+		case float32:
+			o = OfFloat32(v)
+		case float64:
+			o = OfFloat64(v)
+		case string:
+			o = OfString(v)
+		}
+
+		want, wantErr := json.Marshal(v)
+		data, err := o.MarshalJSON()
+		if string(data) != string(want) || (err != nil) != (wantErr != nil) {
+			t.Errorf("%#v MarshalJSON got %s, %v, want %s, %v", o, data, err, want, wantErr)
+		}
+
+		s := o.(fmt.Stringer).String()
+		if wantS := fmt.Sprintf("%v", v); s != wantS {
+			t.Errorf("%#v String got %q, want %q", o, s, wantS)
+		}
+	}
+}
+
+func TestStringFastNonFinite(t *testing.T) {
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if s, want := OfFloat64(f).String(), fmt.Sprintf("%v", f); s != want {
+			t.Errorf("%v String got %q, want %q", f, s, want)
+		}
+		if s, want := OfFloat32(float32(f)).String(), fmt.Sprintf("%v", float32(f)); s != want {
+			t.Errorf("%v String got %q, want %q", float32(f), s, want)
+		}
+	}
+}
+
+func TestUnmarshalJSONFast(t *testing.T) {
+	data := []string{
+		`true`, `false`, `0`, `-0`, `1`, `-1`, `01`, `+1`, `1.0`, `1e2`, `1E+2`, `-1.5e-3`, `.5`, `1.`, `1e`, `-`,
+		`127`, `128`, `-128`, `-129`, `255`, `256`, `4294967296`,
+		`9223372036854775807`, `9223372036854775808`, `18446744073709551615`, `18446744073709551616`,
+		`3.4e38`, `3.5e38`, `1e400`, `0x10`, `1_000`, ` 1`, `1 `, `nan`, `TRUE`,
+		`""`, `"hello"`, `"a\"b"`, `"é"`, `"日本語"`, `"<&>"`, "\"\x01\"", "\"\xff\"", `"1"`, `"true"`, `"`, `"a`,
+	}
+
+	for _, d := range data {
+		testUnmarshalJSONFast[bool, Bool](t, d)
+		testUnmarshalJSONFast[int, Int](t, d)
+		testUnmarshalJSONFast[int8, Int8](t, d)
+		testUnmarshalJSONFast[int16, Int16](t, d)
+		testUnmarshalJSONFast[int32, Int32](t, d)
+		testUnmarshalJSONFast[int64, Int64](t, d)
+		testUnmarshalJSONFast[uint, Uint](t, d)
+		testUnmarshalJSONFast[uint8, Uint8](t, d)
+		testUnmarshalJSONFast[uint16, Uint16](t, d)
+		testUnmarshalJSONFast[uint32, Uint32](t, d)
+		testUnmarshalJSONFast[uint64, Uint64](t, d)
+		testUnmarshalJSONFast[uintptr, Uintptr](t, d)
+		testUnmarshalJSONFast[float32, Float32](t, d)
+		testUnmarshalJSONFast[float64, Float64](t, d)
+		testUnmarshalJSONFast[string, String](t, d)
+	}
+}
+
+// testUnmarshalJSONFast checks that the optional O unmarshals the data into
+// the same value, or returns an error for the same data, as encoding/json
+// does for the type T that it wraps.
+func testUnmarshalJSONFast[T comparable, O interface{ Get() (T, bool) }, P interface {
+	*O
+	json.Unmarshaler
+}](t *testing.T, data string) {
+	t.Helper()
+	var want T
+	wantErr := json.Unmarshal([]byte(data), &want)
+	var o O
+	err := P(&o).UnmarshalJSON([]byte(data))
+	if (err != nil) != (wantErr != nil) {
+		t.Errorf("%T UnmarshalJSON(%s) got err %v, want %v", o, data, err, wantErr)
+		return
+	}
+	if err != nil {
+		return
+	}
+	if v, ok := o.Get(); !ok || v != want {
+		t.Errorf("%T UnmarshalJSON(%s) got %#v, %v, want %#v", o, data, v, ok, want)
+	}
+}
@@ -0,0 +1,33 @@
+//go:build !goexperiment.jsonv2
+
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestJSONMapKey(t *testing.T) {
+	strings := map[String]int{OfString("one"): 1}
+	data, err := json.Marshal(strings)
+	if err != nil || string(data) != `{"one":1}` {
+		t.Fatalf("String keys json.Marshal got %s, %v, want %s", data, err, `{"one":1}`)
+	}
+	var fromStrings map[String]int
+	err = json.Unmarshal(data, &fromStrings)
+	if err != nil || fromStrings[OfString("one")] != 1 {
+		t.Errorf("%s String keys json.Unmarshal got %v, %v, want %v", data, fromStrings, err, strings)
+	}
+
+	times := map[Time]int{OfTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)): 1}
+	data, err = json.Marshal(times)
+	if err != nil {
+		t.Fatalf("Time keys json.Marshal got %s, %v", data, err)
+	}
+	var fromTimes map[Time]int
+	err = json.Unmarshal(data, &fromTimes)
+	if err != nil || len(fromTimes) != 1 || fromTimes[OfTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))] != 1 {
+		t.Errorf("%s Time keys json.Unmarshal got %v, %v, want %v", data, fromTimes, err, times)
+	}
+}
@@ -0,0 +1,153 @@
+//go:build goexperiment.jsonv2
+
+package optional
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"errors"
+	"reflect"
+)
+
+// The methods in this file implement the encoding/json/v2 MarshalerTo and
+// UnmarshalerFrom interfaces, which are only available when building with
+// GOEXPERIMENT=jsonv2. Values that need special handling, such as complex
+// numbers, NaN and infinite floats, and numbers and bools in strings, return
+// errors.ErrUnsupported so that encoding/json/v2 uses MarshalJSON and
+// UnmarshalJSON instead, which handle them the same as encoding/json.
+
+func marshalJSONTo[T any](enc *jsontext.Encoder, value T, ok bool) error {
+	if !ok {
+		return enc.WriteToken(jsontext.Null)
+	}
+	if _, nf := nonFinite(value); nf || isComplex[T]() {
+		return errors.ErrUnsupported
+	}
+	return jsonv2.MarshalEncode(enc, value)
+}
+
+func unmarshalJSONFrom[T any](dec *jsontext.Decoder, set func(value T, ok bool)) error {
+	if isComplex[T]() {
+		return errors.ErrUnsupported
+	}
+	switch dec.PeekKind() {
+	case 'n':
+		_, err := dec.ReadToken()
+		if err != nil {
+			return err
+		}
+		var zero T
+		set(zero, false)
+		return nil
+	case '"':
+		if kind[T]() != reflect.String {
+			return errors.ErrUnsupported
+		}
+	}
+	var v T
+	err := jsonv2.UnmarshalDecode(dec, &v)
+	if err != nil {
+		return err
+	}
+	set(v, true)
+	return nil
+}
+
+// MarshalJSONTo marshals the value being wrapped to the JSON encoder. If there
+// is no value being wrapped, null is marshaled.
+func (o Optional[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, o.value, o.present)
+}
+
+// UnmarshalJSONFrom unmarshals the next JSON value from the decoder into a
+// value wrapped by this optional. A JSON null unmarshals into an empty
+// optional.
+func (o *Optional[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, func(value T, ok bool) {
+		*o = Optional[T]{value: value, present: ok}
+	})
+}
+
+// MarshalJSONTo marshals the value being wrapped to the JSON encoder. If the
+// nullable is null or not set, null is marshaled.
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return n.value.MarshalJSONTo(enc)
+}
+
+// UnmarshalJSONFrom unmarshals the next JSON value from the decoder into a
+// value wrapped by this nullable, setting it. A JSON null sets the nullable to
+// null.
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var o Optional[T]
+	err := o.UnmarshalJSONFrom(dec)
+	if err != nil {
+		return err
+	}
+	*n = SetNullable(o)
+	return nil
+}
+
+// The types that wrap optionals to marshal them in other forms return
+// errors.ErrUnsupported so that their MarshalJSON and UnmarshalJSON are used
+// instead of the methods of the optionals they wrap.
+
+// MarshalJSONTo returns errors.ErrUnsupported so that MarshalJSON is used.
+func (q Quoted[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return errors.ErrUnsupported
+}
+
+// UnmarshalJSONFrom returns errors.ErrUnsupported so that UnmarshalJSON is
+// used.
+func (q *Quoted[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return errors.ErrUnsupported
+}
+
+// MarshalJSONTo returns errors.ErrUnsupported so that MarshalJSON is used.
+func (o UnixTime) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return errors.ErrUnsupported
+}
+
+// UnmarshalJSONFrom returns errors.ErrUnsupported so that UnmarshalJSON is
+// used.
+func (o *UnixTime) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return errors.ErrUnsupported
+}
+
+// MarshalJSONTo returns errors.ErrUnsupported so that MarshalJSON is used.
+func (o UnixMilliTime) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return errors.ErrUnsupported
+}
+
+// UnmarshalJSONFrom returns errors.ErrUnsupported so that UnmarshalJSON is
+// used.
+func (o *UnixMilliTime) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return errors.ErrUnsupported
+}
+
+// MarshalJSONTo returns errors.ErrUnsupported so that MarshalJSON is used.
+func (o LayoutTime[L]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return errors.ErrUnsupported
+}
+
+// UnmarshalJSONFrom returns errors.ErrUnsupported so that UnmarshalJSON is
+// used.
+func (o *LayoutTime[L]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return errors.ErrUnsupported
+}
+
+// MarshalJSONTo returns errors.ErrUnsupported so that MarshalJSON is used.
+func (o RuneChar) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return errors.ErrUnsupported
+}
+
+// UnmarshalJSONFrom returns errors.ErrUnsupported so that UnmarshalJSON is
+// used.
+func (o *RuneChar) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return errors.ErrUnsupported
+}
+
+// UnmarshalJSONFrom returns errors.ErrUnsupported so that UnmarshalJSON is
+// used. Lenient marshals with the MarshalJSONTo of the optional it wraps.
+func (l *Lenient[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return errors.ErrUnsupported
+}
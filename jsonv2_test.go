@@ -0,0 +1,103 @@
+//go:build goexperiment.jsonv2
+
+package optional
+
+import (
+	"encoding/json"
+	jsonv2 "encoding/json/v2"
+	"math"
+	"testing"
+)
+
+func TestJSONv2Marshal(t *testing.T) {
+	s := struct {
+		Int       Int                      `json:"int"`
+		Empty     Int                      `json:"empty"`
+		OmitZero  Int                      `json:"omitzero,omitzero"`
+		String    String                   `json:"string"`
+		Generic   Optional[[]int]          `json:"generic"`
+		Complex   Complex128               `json:"complex"`
+		NaN       NonFiniteString[float64] `json:"nan"`
+		Unset     NullableInt              `json:"unset,omitzero"`
+		Null      NullableInt              `json:"null"`
+		Quoted    Quoted[int64]            `json:"quoted"`
+		UnixTime  UnixTime                 `json:"unixtime"`
+		RuneChar  RuneChar                 `json:"runechar"`
+		GenericNS Nullable[string]         `json:"genericns,omitzero"`
+	}{
+		Int:      OfInt(1),
+		Empty:    EmptyInt(),
+		OmitZero: EmptyInt(),
+		String:   OfString("s"),
+		Generic:  Of([]int{1, 2}),
+		Complex:  OfComplex128(1 + 2i),
+		NaN:      NonFiniteString[float64]{OfFloat64(math.NaN())},
+		Null:     SetNullableInt(EmptyInt()),
+		Quoted:   Quoted[int64]{OfInt64(2)},
+		RuneChar: RuneChar{OfRune('a')},
+	}
+
+	data, err := jsonv2.Marshal(s)
+	want := `{"int":1,"empty":null,"string":"s","generic":[1,2],"complex":"1+2i","nan":"NaN","null":null,"quoted":"2","unixtime":null,"runechar":"a"}`
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal got %s, %v, want %s", data, err, want)
+	}
+}
+
+func TestJSONv2Unmarshal(t *testing.T) {
+	var s struct {
+		Int     Int                      `json:"int"`
+		Empty   Int                      `json:"empty"`
+		Generic Optional[[]int]          `json:"generic"`
+		Complex Complex128               `json:"complex"`
+		Unset   NullableInt              `json:"unset"`
+		Null    NullableInt              `json:"null"`
+		Quoted  Quoted[int64]            `json:"quoted"`
+		Inf     NonFiniteString[float64] `json:"inf"`
+		NaN     NonFiniteNull[float64]   `json:"nan"`
+	}
+	s.Empty = OfInt(1)
+
+	err := jsonv2.Unmarshal([]byte(`{"int":1,"empty":null,"generic":[1,2],"complex":"1+2i","null":null,"quoted":"2","inf":"-Infinity","nan":null}`), &s)
+	if err != nil {
+		t.Fatalf("Unmarshal got %v, want nil", err)
+	}
+	if s.Int != OfInt(1) || s.Empty != EmptyInt() || s.Complex != OfComplex128(1+2i) || s.Quoted != (Quoted[int64]{OfInt64(2)}) || s.Inf.Optional != Of(math.Inf(-1)) || s.NaN.IsPresent() {
+		t.Errorf("Unmarshal got %#v", s)
+	}
+	if g, ok := s.Generic.Get(); !ok || len(g) != 2 {
+		t.Errorf("Unmarshal generic got %#v, want [1 2]", s.Generic)
+	}
+	if s.Unset.IsSet() || !s.Null.IsNull() {
+		t.Errorf("Unmarshal nullables got %#v, %#v, want unset, null", s.Unset, s.Null)
+	}
+
+	err = jsonv2.Unmarshal([]byte(`{"int":"1"}`), &s)
+	if err == nil {
+		t.Errorf("Unmarshal string into Int got nil error, want error")
+	}
+}
+
+func TestJSONMapKey(t *testing.T) {
+	ints := map[Int]int{OfInt(1): 1, OfInt(-2): 2}
+	data, err := json.Marshal(ints)
+	if err != nil || string(data) != `{"-2":2,"1":1}` {
+		t.Fatalf("Int keys json.Marshal got %s, %v, want %s", data, err, `{"-2":2,"1":1}`)
+	}
+	var fromInts map[Int]int
+	err = json.Unmarshal(data, &fromInts)
+	if err != nil || len(fromInts) != 2 || fromInts[OfInt(1)] != 1 || fromInts[OfInt(-2)] != 2 {
+		t.Errorf("%s Int keys json.Unmarshal got %v, %v, want %v", data, fromInts, err, ints)
+	}
+
+	strings := map[String]int{OfString("one"): 1}
+	data, err = json.Marshal(strings)
+	if err != nil || string(data) != `{"one":1}` {
+		t.Fatalf("String keys json.Marshal got %s, %v, want %s", data, err, `{"one":1}`)
+	}
+	var fromStrings map[String]int
+	err = json.Unmarshal(data, &fromStrings)
+	if err != nil || fromStrings[OfString("one")] != 1 {
+		t.Errorf("%s String keys json.Unmarshal got %v, %v, want %v", data, fromStrings, err, strings)
+	}
+}
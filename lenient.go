@@ -0,0 +1,43 @@
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Lenient wraps an optional and unmarshals it from JSON leniently, for JSON
+// from systems that quote numbers and bools. Numbers are also unmarshaled from
+// JSON strings that contain a JSON number, such as "42", and bools from the
+// JSON strings "true" and "false". An empty JSON string unmarshals into an
+// empty optional unless the type wrapped is a string. Lenient marshals the
+// same as the optional it wraps.
+type Lenient[T any] struct {
+	Optional[T]
+}
+
+// UnmarshalJSON unmarshals the JSON, or the number or bool quoted in a JSON
+// string, into a value wrapped by this optional. A JSON null or empty string
+// unmarshals into an empty optional.
+func (l *Lenient[T]) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' || kind[T]() == reflect.String {
+		return l.Optional.UnmarshalJSON(data)
+	}
+	if string(data) == `""` {
+		l.Optional = Empty[T]()
+		return nil
+	}
+	if !isNumberOrBool[T]() || isComplex[T]() {
+		return l.Optional.UnmarshalJSON(data)
+	}
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	if kind[T]() == reflect.Bool && s != "true" && s != "false" ||
+		kind[T]() != reflect.Bool && !isJSONNumber([]byte(s), false) {
+		return fmt.Errorf("optional: cannot unmarshal JSON string %s into %s", data, reflect.TypeFor[T]())
+	}
+	return l.Optional.UnmarshalJSON([]byte(s))
+}
@@ -0,0 +1,33 @@
+package optional
+
+// Map returns an optional wrapping the result of calling the function with the
+// value wrapped by the optional o, or an empty optional if o is empty. The
+// optional o may be any of the optional types, such as Optional or Int, and the
+// function may return a different type to the one wrapped by o, such as:
+//
+//	s := optional.Map(optional.OfInt(1), strconv.Itoa) // Optional[string]
+func Map[T, U any](o interface{ Get() (T, bool) }, f func(value T) U) Optional[U] {
+	v, ok := o.Get()
+	if !ok {
+		return Empty[U]()
+	}
+	return Of(f(v))
+}
+
+// FlatMap returns the optional returned by calling the function with the value
+// wrapped by the optional o, or an empty optional of the type the function
+// returns if o is empty. The optionals may be any of the optional types, and
+// of different types, such as:
+//
+//	s := optional.FlatMap(optional.OfInt(1), func(i int) optional.String {
+//		return optional.OfString(strconv.Itoa(i))
+//	})
+func FlatMap[T any, R interface{ IsPresent() bool }](o interface{ Get() (T, bool) }, f func(value T) R) R {
+	v, ok := o.Get()
+	if !ok {
+		// The zero value of every optional type is empty.
+		var empty R
+		return empty
+	}
+	return f(v)
+}
@@ -0,0 +1,87 @@
+package optional
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	if o := Map(OfInt(42), strconv.Itoa); o != Of("42") {
+		t.Errorf("Map(OfInt(42)) got %#v, want %#v", o, Of("42"))
+	}
+	if o := Map(EmptyInt(), strconv.Itoa); o != Empty[string]() {
+		t.Errorf("Map(EmptyInt()) got %#v, want empty", o)
+	}
+	if o := Map(Of("1.5"), func(s string) float64 { return 1.5 }); o != Of(1.5) {
+		t.Errorf("Map(Of(\"1.5\")) got %#v, want %#v", o, Of(1.5))
+	}
+	if o := Map(OfNullableInt(1), func(i int) bool { return i > 0 }); o != Of(true) {
+		t.Errorf("Map(OfNullableInt(1)) got %#v, want %#v", o, Of(true))
+	}
+	if o := Map(SetNullableInt(EmptyInt()), func(i int) bool { return i > 0 }); o != Empty[bool]() {
+		t.Errorf("Map(SetNullableInt(EmptyInt())) got %#v, want empty", o)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	parse := func(s string) Int {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return EmptyInt()
+		}
+		return OfInt(i)
+	}
+	tests := []struct {
+		Optional         String
+		ExpectedOptional Int
+	}{
+		{EmptyString(), EmptyInt()},
+		{OfString("x"), EmptyInt()},
+		{OfString("42"), OfInt(42)},
+	}
+
+	for _, test := range tests {
+		o := FlatMap(test.Optional, parse)
+
+		if o != test.ExpectedOptional {
+			t.Errorf("FlatMap(%#v) got %#v, want %#v", test.Optional, o, test.ExpectedOptional)
+		}
+	}
+
+	if o := FlatMap(Of(1), func(i int) Optional[string] { return Of(strconv.Itoa(i)) }); o != Of("1") {
+		t.Errorf("FlatMap(Of(1)) got %#v, want %#v", o, Of("1"))
+	}
+}
+
+func TestOptionalMapFilter(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	even := func(i int) bool { return i%2 == 0 }
+	half := func(i int) Optional[int] {
+		if i%2 != 0 {
+			return Empty[int]()
+		}
+		return Of(i / 2)
+	}
+	tests := []struct {
+		Optional        Optional[int]
+		ExpectedMap     Optional[int]
+		ExpectedFlatMap Optional[int]
+		ExpectedFilter  Optional[int]
+	}{
+		{Empty[int](), Empty[int](), Empty[int](), Empty[int]()},
+		{Of(3), Of(6), Empty[int](), Empty[int]()},
+		{Of(4), Of(8), Of(2), Of(4)},
+	}
+
+	for _, test := range tests {
+		if o := test.Optional.Map(double); o != test.ExpectedMap {
+			t.Errorf("%#v Map got %#v, want %#v", test.Optional, o, test.ExpectedMap)
+		}
+		if o := test.Optional.FlatMap(half); o != test.ExpectedFlatMap {
+			t.Errorf("%#v FlatMap got %#v, want %#v", test.Optional, o, test.ExpectedFlatMap)
+		}
+		if o := test.Optional.Filter(even); o != test.ExpectedFilter {
+			t.Errorf("%#v Filter got %#v, want %#v", test.Optional, o, test.ExpectedFilter)
+		}
+	}
+}
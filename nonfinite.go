@@ -0,0 +1,55 @@
+package optional
+
+import "encoding/json"
+
+// NonFiniteNull wraps an optional float and marshals NaN and infinite floats
+// to JSON as null, which JSON does not support, instead of returning an error.
+// A JSON null unmarshals into an empty optional. NonFiniteNull otherwise
+// marshals and unmarshals the same as the optional it wraps.
+type NonFiniteNull[T float32 | float64] struct {
+	Optional[T]
+}
+
+// MarshalJSON marshals the value being wrapped to JSON. If there is no value
+// being wrapped, or the value is NaN or infinite, null is marshaled.
+func (n NonFiniteNull[T]) MarshalJSON() ([]byte, error) {
+	if _, ok := nonFinite(n.ElseZero()); ok && n.IsPresent() {
+		return []byte("null"), nil
+	}
+	return n.Optional.MarshalJSON()
+}
+
+// NonFiniteString wraps an optional float and marshals NaN and infinite floats
+// to JSON as the strings "NaN", "Infinity" and "-Infinity", which JSON does
+// not otherwise support, and unmarshals those strings back into the same
+// values. NonFiniteString otherwise marshals and unmarshals the same as the
+// optional it wraps.
+type NonFiniteString[T float32 | float64] struct {
+	Optional[T]
+}
+
+// MarshalJSON marshals the value being wrapped to JSON. If there is no value
+// being wrapped, null is marshaled, and NaN and infinite floats are marshaled
+// as strings.
+func (n NonFiniteString[T]) MarshalJSON() ([]byte, error) {
+	if f, ok := nonFinite(n.ElseZero()); ok && n.IsPresent() {
+		return json.Marshal(formatNonFinite(f))
+	}
+	return n.Optional.MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the JSON into a value wrapped by this optional,
+// including the strings "NaN", "Infinity" and "-Infinity". A JSON null
+// unmarshals into an empty optional.
+func (n *NonFiniteString[T]) UnmarshalJSON(data []byte) error {
+	if !isNonFiniteJSON[T](data) {
+		return n.Optional.UnmarshalJSON(data)
+	}
+	var v T
+	err := unmarshalJSONText[T](&v, data)
+	if err != nil {
+		return err
+	}
+	n.Optional = Of(v)
+	return nil
+}
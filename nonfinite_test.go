@@ -0,0 +1,79 @@
+package optional
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestNonFiniteNull(t *testing.T) {
+	tests := []struct {
+		Float        NonFiniteNull[float64]
+		ExpectedJSON string
+		Unmarshaled  NonFiniteNull[float64]
+	}{
+		{NonFiniteNull[float64]{}, `null`, NonFiniteNull[float64]{}},
+		{NonFiniteNull[float64]{Of(1.5)}, `1.5`, NonFiniteNull[float64]{Of(1.5)}},
+		{NonFiniteNull[float64]{Of(math.Inf(1))}, `null`, NonFiniteNull[float64]{}},
+		{NonFiniteNull[float64]{Of(math.NaN())}, `null`, NonFiniteNull[float64]{}},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(test.Float)
+		if err != nil || string(data) != test.ExpectedJSON {
+			t.Errorf("%#v json.Marshal got %s, %v, want %s", test.Float, data, err, test.ExpectedJSON)
+		}
+		var f NonFiniteNull[float64]
+		err = json.Unmarshal(data, &f)
+		if err != nil || f != test.Unmarshaled {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v", data, f, err, test.Unmarshaled)
+		}
+	}
+}
+
+func TestNonFiniteString(t *testing.T) {
+	tests := []struct {
+		Float        NonFiniteString[float32]
+		ExpectedJSON string
+	}{
+		{NonFiniteString[float32]{}, `null`},
+		{NonFiniteString[float32]{Of(float32(1.5))}, `1.5`},
+		{NonFiniteString[float32]{Of(float32(math.Inf(1)))}, `"Infinity"`},
+		{NonFiniteString[float32]{Of(float32(math.Inf(-1)))}, `"-Infinity"`},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(test.Float)
+		if err != nil || string(data) != test.ExpectedJSON {
+			t.Errorf("%#v json.Marshal got %s, %v, want %s", test.Float, data, err, test.ExpectedJSON)
+		}
+		var f NonFiniteString[float32]
+		err = json.Unmarshal(data, &f)
+		if err != nil || f != test.Float {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v", data, f, err, test.Float)
+		}
+	}
+
+	nan := NonFiniteString[float64]{Of(math.NaN())}
+	data, err := json.Marshal(nan)
+	if err != nil || string(data) != `"NaN"` {
+		t.Fatalf("NaN json.Marshal got %s, %v, want %s", data, err, `"NaN"`)
+	}
+	var f NonFiniteString[float64]
+	err = json.Unmarshal(data, &f)
+	if v, ok := f.Get(); err != nil || !ok || !math.IsNaN(v) {
+		t.Errorf("%s json.Unmarshal got %#v, %v, want NaN", data, f, err)
+	}
+}
+
+func TestNonFiniteStringStrict(t *testing.T) {
+	tests := []string{`"1.5"`, `"Inf"`, `"nan"`, `"+Infinity"`, `""`}
+
+	for _, data := range tests {
+		var f NonFiniteString[float64]
+		err := json.Unmarshal([]byte(data), &f)
+		if err == nil {
+			t.Errorf("%s json.Unmarshal got %#v, want error", data, f)
+		}
+	}
+}
@@ -0,0 +1,1023 @@
+package optional
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Optional wraps a value of any type that may or may not be nil.
+// If a value is present, it may be unwrapped to expose the underlying value.
+//
+// The named optional types, such as Int and Time, are aliases of Optional,
+// such as Optional[int] and Optional[time.Time].
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Of wraps the value in an optional.
+func Of[T any](value T) Optional[T] {
+	return Optional[T]{value: value, present: true}
+}
+
+// OfPtr wraps the value pointed to in an optional, or returns an empty
+// optional if the pointer is nil.
+func OfPtr[T any](ptr *T) Optional[T] {
+	if ptr == nil {
+		return Empty[T]()
+	} else {
+		return Of(*ptr)
+	}
+}
+
+// Empty returns an empty optional.
+func Empty[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Get returns the value wrapped by this optional, and an ok signal for whether a value was wrapped.
+func (o Optional[T]) Get() (value T, ok bool) {
+	o.If(func(v T) {
+		value = v
+		ok = true
+	})
+	return
+}
+
+// Ptr returns a pointer to a copy of the value wrapped by this optional, or
+// nil if there is no value wrapped by this optional.
+func (o Optional[T]) Ptr() *T {
+	if !o.IsPresent() {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
+// SetPtr sets this optional to wrap the value pointed to, or to be empty if
+// the pointer is nil.
+func (o *Optional[T]) SetPtr(ptr *T) {
+	*o = OfPtr(ptr)
+}
+
+// IsPresent returns true if there is a value wrapped by this optional.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// IsZero returns true if there is no value wrapped by this optional. It
+// allows the `omitzero` JSON struct tag option to omit empty optionals.
+func (o Optional[T]) IsZero() bool {
+	return !o.present
+}
+
+// If calls the function if there is a value wrapped by this optional.
+func (o Optional[T]) If(f func(value T)) {
+	if o.IsPresent() {
+		f(o.value)
+	}
+}
+
+// ElseFunc returns the value wrapped by this optional, or the value returned
+// by the function if there is no value wrapped by this optional.
+func (o Optional[T]) ElseFunc(f func() T) (value T) {
+	if o.IsPresent() {
+		o.If(func(v T) { value = v })
+		return
+	} else {
+		return f()
+	}
+}
+
+// Else returns the value wrapped by this optional, or the value passed in if
+// there is no value wrapped by this optional.
+func (o Optional[T]) Else(elseValue T) (value T) {
+	return o.ElseFunc(func() T { return elseValue })
+}
+
+// ElseZero returns the value wrapped by this optional, or the zero value of
+// the type wrapped if there is no value wrapped by this optional.
+func (o Optional[T]) ElseZero() (value T) {
+	var zero T
+	return o.Else(zero)
+}
+
+// GetOrError returns the value wrapped by this optional, or the error if there
+// is no value wrapped by this optional. If the error is nil, an error wrapping
+// ErrEmpty is returned instead.
+func (o Optional[T]) GetOrError(err error) (value T, _ error) {
+	if o.IsPresent() {
+		return o.value, nil
+	}
+	if err == nil {
+		return value, o.emptyError()
+	}
+	return value, err
+}
+
+// ElseErr returns the value wrapped by this optional, or the error returned by
+// calling the function if there is no value wrapped by this optional. If the
+// function returns nil, an error wrapping ErrEmpty is returned instead.
+func (o Optional[T]) ElseErr(f func() error) (value T, err error) {
+	if o.IsPresent() {
+		return o.value, nil
+	}
+	return o.GetOrError(f())
+}
+
+// MustGet returns the value wrapped by this optional, or panics with an error
+// wrapping ErrEmpty and naming the type of this optional if there is no value
+// wrapped by this optional.
+func (o Optional[T]) MustGet() T {
+	if !o.IsPresent() {
+		panic(o.emptyError())
+	}
+	return o.value
+}
+
+// Expect returns the value wrapped by this optional, or panics with an error
+// wrapping ErrEmpty and starting with the message if there is no value wrapped
+// by this optional.
+func (o Optional[T]) Expect(msg string) T {
+	if !o.IsPresent() {
+		panic(fmt.Errorf("%s: %w", msg, o.emptyError()))
+	}
+	return o.value
+}
+
+func (o Optional[T]) emptyError() error {
+	return fmt.Errorf("%w by %T", ErrEmpty, o)
+}
+
+// Or returns this optional if there is a value wrapped by it, or the other
+// optional if there is not.
+func (o Optional[T]) Or(other Optional[T]) Optional[T] {
+	if o.IsPresent() {
+		return o
+	}
+	return other
+}
+
+// OrFunc returns this optional if there is a value wrapped by it, or the
+// optional returned by calling the function if there is not.
+func (o Optional[T]) OrFunc(f func() Optional[T]) Optional[T] {
+	if o.IsPresent() {
+		return o
+	}
+	return f()
+}
+
+// Map returns an optional wrapping the result of calling the function with the
+// value wrapped by this optional, or an empty optional if there is no value
+// wrapped by this optional.
+func (o Optional[T]) Map(f func(value T) T) Optional[T] {
+	if !o.IsPresent() {
+		return Empty[T]()
+	}
+	return Of(f(o.value))
+}
+
+// FlatMap returns the optional returned by calling the function with the value
+// wrapped by this optional, or an empty optional if there is no value wrapped
+// by this optional.
+func (o Optional[T]) FlatMap(f func(value T) Optional[T]) Optional[T] {
+	if !o.IsPresent() {
+		return Empty[T]()
+	}
+	return f(o.value)
+}
+
+// Filter returns this optional if the function returns true for the value
+// wrapped by it, or an empty optional if the function returns false or there
+// is no value wrapped by this optional.
+func (o Optional[T]) Filter(f func(value T) bool) Optional[T] {
+	if !o.IsPresent() || !f(o.value) {
+		return Empty[T]()
+	}
+	return o
+}
+
+// String returns the string representation of the wrapped value, or the string
+// representation of the zero value of the type wrapped if there is no value
+// wrapped by this optional.
+func (o Optional[T]) String() string {
+	v := o.ElseZero()
+	if s, ok := format[T](v); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// MarshalJSON marshals the value being wrapped to JSON. If there is no value
+// being wrapped, null is marshaled, the same as a nil pointer. Complex numbers
+// are marshaled as a string, such as "1+2i". NaN and infinite floats return an
+// error, unless wrapped in NonFiniteNull or NonFiniteString.
+func (o Optional[T]) MarshalJSON() (data []byte, err error) {
+	if !o.IsPresent() {
+		return []byte("null"), nil
+	}
+	if isComplex[T]() {
+		text, err := appendText[T](nil, o.value)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(text))
+	}
+	if data, ok := appendJSON[T](nil, o.value); ok {
+		return data, nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON unmarshals the JSON into a value wrapped by this optional. A
+// JSON null unmarshals into an empty optional.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Optional[T]{}
+		return nil
+	}
+	if v, ok := parseJSON[T](data); ok {
+		*o = Of(v)
+		return nil
+	}
+	var v T
+	var err error
+	if isComplex[T]() {
+		err = unmarshalJSONText[T](&v, data)
+	} else {
+		err = json.Unmarshal(data, &v)
+	}
+	if err != nil {
+		return err
+	}
+	*o = Of(v)
+	return nil
+}
+
+// unmarshalJSONText unmarshals a JSON string or number using the same form as
+// UnmarshalText.
+func unmarshalJSONText[T any](value *T, data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return unmarshalText[T](value, data)
+}
+
+// MarshalXML marshals the value being wrapped to XML. If there is no value
+// being wrapped, no element is marshaled, the same as a nil pointer. Use
+// XMLNil to marshal an element with the attribute xsi:nil="true" instead.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.IsPresent() {
+		return nil
+	}
+	if isComplex[T]() {
+		text, err := appendText[T](nil, o.value)
+		if err != nil {
+			return err
+		}
+		return e.EncodeElement(string(text), start)
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML unmarshals the XML into a value wrapped by this optional. An
+// element with the attribute xsi:nil="true" unmarshals into an empty optional.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if isXSINil(start) {
+		*o = Empty[T]()
+		return d.Skip()
+	}
+	var v T
+	var err error
+	if isComplex[T]() {
+		var s string
+		err = d.DecodeElement(&s, &start)
+		if err == nil {
+			err = unmarshalText[T](&v, []byte(strings.TrimSpace(s)))
+		}
+	} else {
+		err = d.DecodeElement(&v, &start)
+	}
+	if err != nil {
+		return err
+	}
+	*o = Of(v)
+	return nil
+}
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+func encodeXSINil(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+func isXSINil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") && attr.Name.Local == "nil" {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
+
+// MarshalXMLAttr marshals the value being wrapped to an XML attribute, using
+// the same form as MarshalText. If there is no value being wrapped, no
+// attribute is marshaled.
+func (o Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.IsPresent() {
+		return xml.Attr{}, nil
+	}
+	text, err := appendText[T](nil, o.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr unmarshals the XML attribute into a value wrapped by this
+// optional, using the same form as UnmarshalText. A missing attribute is not
+// unmarshaled, leaving the optional empty. An empty attribute unmarshals into
+// an empty optional, except for strings.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if kind[T]() != reflect.String {
+		return o.UnmarshalText([]byte(attr.Value))
+	}
+	var v T
+	err := unmarshalText[T](&v, []byte(attr.Value))
+	if err != nil {
+		return err
+	}
+	*o = Of(v)
+	return nil
+}
+
+// MarshalYAML implements the Marshaler interface of YAML libraries such as
+// gopkg.in/yaml.v3, without importing them. If there is no value being
+// wrapped, null is marshaled. Complex numbers are marshaled as a string, the
+// same as MarshalText.
+func (o Optional[T]) MarshalYAML() (interface{}, error) {
+	if !o.IsPresent() {
+		return nil, nil
+	}
+	if isComplex[T]() {
+		text, err := appendText[T](nil, o.value)
+		if err != nil {
+			return nil, err
+		}
+		return string(text), nil
+	}
+	return o.value, nil
+}
+
+// UnmarshalYAML implements the Unmarshaler interface of YAML libraries such as
+// gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports, without importing
+// them. A YAML null unmarshals into an empty optional.
+func (o *Optional[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if isComplex[T]() {
+		var s *string
+		err := unmarshal(&s)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			*o = Empty[T]()
+			return nil
+		}
+		return o.UnmarshalText([]byte(*s))
+	}
+	var v *T
+	err := unmarshal(&v)
+	if err != nil {
+		return err
+	}
+	*o = OfPtr(v)
+	return nil
+}
+
+// MarshalText marshals the value being wrapped to text, using the same form
+// as strconv for builtin types, without parentheses for complex numbers, and
+// the type's own MarshalText if it has one, such as RFC 3339 for time.Time. If
+// there is no value being wrapped, empty text is marshaled.
+func (o Optional[T]) MarshalText() (text []byte, err error) {
+	return o.AppendText(nil)
+}
+
+// AppendText appends the text form of the value being wrapped to b, the same
+// as MarshalText.
+func (o Optional[T]) AppendText(b []byte) ([]byte, error) {
+	if !o.IsPresent() {
+		return b, nil
+	}
+	return appendText[T](b, o.value)
+}
+
+// UnmarshalText unmarshals the text into a value wrapped by this optional.
+// Empty text unmarshals into an empty optional.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Empty[T]()
+		return nil
+	}
+	var v T
+	err := unmarshalText[T](&v, text)
+	if err != nil {
+		return err
+	}
+	*o = Of(v)
+	return nil
+}
+
+func appendText[T any](b []byte, value T) ([]byte, error) {
+	switch v := interface{}(value).(type) {
+	case encoding.TextAppender:
+		return v.AppendText(b)
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		return append(b, text...), err
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		return append(b, rv.String()...), nil
+	case reflect.Bool:
+		return strconv.AppendBool(b, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(b, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(b, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(b, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	case reflect.Complex64, reflect.Complex128:
+		s := strconv.FormatComplex(rv.Complex(), 'g', -1, rv.Type().Bits())
+		return append(b, s[1:len(s)-1]...), nil
+	}
+	return b, fmt.Errorf("optional: cannot marshal %T to text", value)
+}
+
+func unmarshalText[T any](value *T, text []byte) error {
+	if u, ok := interface{}(value).(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(text)
+	}
+	rv := reflect.ValueOf(value).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(string(text))
+		return nil
+	case reflect.Bool:
+		v, err := strconv.ParseBool(string(text))
+		rv.SetBool(v)
+		return err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(string(text), 10, rv.Type().Bits())
+		rv.SetInt(v)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err := strconv.ParseUint(string(text), 10, rv.Type().Bits())
+		rv.SetUint(v)
+		return err
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(string(text), rv.Type().Bits())
+		rv.SetFloat(v)
+		return err
+	case reflect.Complex64, reflect.Complex128:
+		v, err := strconv.ParseComplex(string(text), rv.Type().Bits())
+		rv.SetComplex(v)
+		return err
+	}
+	return fmt.Errorf("optional: cannot unmarshal text into %T", *value)
+}
+
+// The first byte of the binary form of an optional is whether a value is
+// present, which also versions the form. Other values are reserved for future
+// versions.
+const (
+	binaryEmpty   byte = 0
+	binaryPresent byte = 1
+)
+
+// MarshalBinary marshals the value being wrapped to its binary form, which is
+// a byte for whether a value is present followed by the value. Integers are
+// marshaled as varints, floats and complex numbers as fixed-width little
+// endian, strings as their bytes, types with their own MarshalBinary, such as
+// time.Time, with it, and other types with encoding/gob.
+func (o Optional[T]) MarshalBinary() (data []byte, err error) {
+	return o.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary form of the optional to b, the same as
+// MarshalBinary.
+func (o Optional[T]) AppendBinary(b []byte) ([]byte, error) {
+	if !o.IsPresent() {
+		return append(b, binaryEmpty), nil
+	}
+	return appendBinary[T](append(b, binaryPresent), o.value)
+}
+
+// UnmarshalBinary unmarshals the binary form of an optional, as marshaled by
+// MarshalBinary, into this optional.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("optional: cannot unmarshal empty binary")
+	}
+	switch data[0] {
+	case binaryEmpty:
+		if len(data) != 1 {
+			return fmt.Errorf("optional: cannot unmarshal binary with a value into an empty optional")
+		}
+		*o = Empty[T]()
+		return nil
+	case binaryPresent:
+		var v T
+		err := unmarshalBinary[T](&v, data[1:])
+		if err != nil {
+			return err
+		}
+		*o = Of(v)
+		return nil
+	}
+	return fmt.Errorf("optional: cannot unmarshal binary with unsupported version %d", data[0])
+}
+
+func appendBinary[T any](b []byte, value T) ([]byte, error) {
+	switch v := interface{}(value).(type) {
+	case encoding.BinaryAppender:
+		return v.AppendBinary(b)
+	case encoding.BinaryMarshaler:
+		data, err := v.MarshalBinary()
+		return append(b, data...), err
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		return append(b, rv.String()...), nil
+	case reflect.Bool:
+		if rv.Bool() {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(b, rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(b, rv.Uint()), nil
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(rv.Float()))), nil
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(rv.Float())), nil
+	case reflect.Complex64:
+		c := rv.Complex()
+		b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(real(c))))
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(imag(c)))), nil
+	case reflect.Complex128:
+		c := rv.Complex()
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(real(c)))
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(imag(c))), nil
+	}
+	buf := bytes.NewBuffer(b)
+	err := gob.NewEncoder(buf).Encode(value)
+	if err != nil {
+		return b, fmt.Errorf("optional: cannot marshal %T to binary: %w", value, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalBinary[T any](value *T, data []byte) error {
+	if u, ok := interface{}(value).(encoding.BinaryUnmarshaler); ok {
+		return u.UnmarshalBinary(data)
+	}
+	rv := reflect.ValueOf(value).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(string(data))
+		return nil
+	case reflect.Bool:
+		if len(data) == 1 && data[0] <= 1 {
+			rv.SetBool(data[0] == 1)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, n := binary.Varint(data)
+		if n == len(data) && !rv.OverflowInt(v) {
+			rv.SetInt(v)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, n := binary.Uvarint(data)
+		if n == len(data) && !rv.OverflowUint(v) {
+			rv.SetUint(v)
+			return nil
+		}
+	case reflect.Float32:
+		if len(data) == 4 {
+			rv.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(data))))
+			return nil
+		}
+	case reflect.Float64:
+		if len(data) == 8 {
+			rv.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+			return nil
+		}
+	case reflect.Complex64:
+		if len(data) == 8 {
+			re := math.Float32frombits(binary.LittleEndian.Uint32(data))
+			im := math.Float32frombits(binary.LittleEndian.Uint32(data[4:]))
+			rv.SetComplex(complex(float64(re), float64(im)))
+			return nil
+		}
+	case reflect.Complex128:
+		if len(data) == 16 {
+			re := math.Float64frombits(binary.LittleEndian.Uint64(data))
+			im := math.Float64frombits(binary.LittleEndian.Uint64(data[8:]))
+			rv.SetComplex(complex(re, im))
+			return nil
+		}
+	default:
+		err := gob.NewDecoder(bytes.NewReader(data)).Decode(value)
+		if err != nil {
+			return fmt.Errorf("optional: cannot unmarshal binary %x into %T: %w", data, *value, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("optional: cannot unmarshal binary %x into %T", data, *value)
+}
+
+// isComplex returns true if the type wrapped is a complex number, which JSON
+// and XML do not support and so are marshaled the same as MarshalText.
+func isComplex[T any]() bool {
+	switch kind[T]() {
+	case reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// isNumberOrBool returns true if the type wrapped is a number or a bool.
+func isNumberOrBool[T any]() bool {
+	switch kind[T]() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// nonFinite returns the value as a float64 and true if the type wrapped is a
+// float and the value is NaN or infinite.
+func nonFinite[T any](value T) (float64, bool) {
+	switch kind[T]() {
+	case reflect.Float32, reflect.Float64:
+		f := reflect.ValueOf(value).Float()
+		return f, math.IsNaN(f) || math.IsInf(f, 0)
+	}
+	return 0, false
+}
+
+// isNonFiniteJSON returns true if the type wrapped is a float and the data is
+// one of the JSON strings that NaN and infinite floats are marshaled as.
+func isNonFiniteJSON[T any](data []byte) bool {
+	switch kind[T]() {
+	case reflect.Float32, reflect.Float64:
+		switch string(data) {
+		case `"NaN"`, `"Infinity"`, `"-Infinity"`:
+			return true
+		}
+	}
+	return false
+}
+
+func formatNonFinite(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	return "NaN"
+}
+
+// kind returns the kind of the type wrapped.
+func kind[T any]() reflect.Kind {
+	return reflect.TypeOf((*T)(nil)).Elem().Kind()
+}
+
+// Scan implements sql.Scanner. A SQL NULL scans into an empty optional, and
+// other values are converted to the type wrapped, returning an error if the
+// value cannot be represented by the type wrapped. Times also scan from
+// strings and bytes in the RFC 3339 form, which some drivers return.
+func (o *Optional[T]) Scan(src interface{}) error {
+	var zero T
+	if _, ok := interface{}(zero).(time.Time); ok {
+		var text string
+		switch s := src.(type) {
+		case string:
+			text = s
+		case []byte:
+			text = string(s)
+		}
+		if text != "" {
+			t, err := time.Parse(time.RFC3339Nano, text)
+			if err != nil {
+				return fmt.Errorf("optional: cannot scan %q into %T: %w", text, zero, err)
+			}
+			*o = Of(interface{}(t).(T))
+			return nil
+		}
+	}
+	var n sql.Null[T]
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+	*o = OfSQLNull(n)
+	return nil
+}
+
+// Value implements driver.Valuer. If there is no value being wrapped, SQL NULL
+// is returned. Uintptrs, which database/sql does not support, are returned as
+// an int64, returning an error if the value is out of range.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if _, ok := interface{}(o.value).(driver.Valuer); !ok && o.present && kind[T]() == reflect.Uintptr {
+		u := reflect.ValueOf(o.value).Uint()
+		if u > math.MaxInt64 {
+			return nil, fmt.Errorf("optional: cannot convert %v to int64: %w", o.value, ErrOverflow)
+		}
+		return int64(u), nil
+	}
+	return o.ToSQLNull().Value()
+}
+
+// OfSQLNull wraps the value of the sql.Null in an optional, or returns an empty
+// optional if the sql.Null is not valid.
+func OfSQLNull[T any](n sql.Null[T]) Optional[T] {
+	if !n.Valid {
+		return Empty[T]()
+	}
+	return Of(n.V)
+}
+
+// ToSQLNull returns the value wrapped by this optional as a sql.Null, which
+// is not valid if there is no value wrapped by this optional.
+func (o Optional[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{V: o.value, Valid: o.present}
+}
+
+// Nullable wraps an optional of any type and whether it has been set, to
+// distinguish between a value that was not set, a value that was set to null,
+// and a value that was set to a value.
+//
+// The named nullable types, such as NullableInt and NullableTime, are aliases
+// of Nullable, such as Nullable[int] and Nullable[time.Time].
+type Nullable[T any] struct {
+	value Optional[T]
+	set   bool
+}
+
+// OfNullable returns a nullable that is set to the value.
+func OfNullable[T any](value T) Nullable[T] {
+	return SetNullable(Of(value))
+}
+
+// SetNullable returns a nullable that is set to the optional. The nullable
+// is null if the optional is empty.
+func SetNullable[T any](o Optional[T]) Nullable[T] {
+	return Nullable[T]{value: o, set: true}
+}
+
+// UnsetNullable returns a nullable that is not set.
+func UnsetNullable[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// IsSet returns true if this nullable has been set, either to null or to a
+// value.
+func (n Nullable[T]) IsSet() bool {
+	return n.set
+}
+
+// IsNull returns true if this nullable has been set to null.
+func (n Nullable[T]) IsNull() bool {
+	return n.set && !n.value.IsPresent()
+}
+
+// IsZero returns true if this nullable has not been set. It allows the
+// `omitzero` JSON struct tag option to omit unset nullables.
+func (n Nullable[T]) IsZero() bool {
+	return !n.set
+}
+
+// Get returns the value wrapped by this nullable, and an ok signal for whether
+// it has been set to a value.
+func (n Nullable[T]) Get() (value T, ok bool) {
+	return n.value.Get()
+}
+
+// Optional returns the optional wrapped by this nullable, which is empty if
+// the nullable is not set or is null.
+func (n Nullable[T]) Optional() Optional[T] {
+	return n.value
+}
+
+// String returns the string representation of the wrapped optional.
+func (n Nullable[T]) String() string {
+	return n.value.String()
+}
+
+// MarshalJSON marshals the value being wrapped to JSON. If the nullable is
+// null or not set, null is marshaled. Use `omitzero` to omit the field when
+// the nullable is not set.
+func (n Nullable[T]) MarshalJSON() (data []byte, err error) {
+	return n.value.MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the JSON into a value wrapped by this nullable,
+// setting it. A JSON null sets the nullable to null.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	var o Optional[T]
+	err := o.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*n = SetNullable(o)
+	return nil
+}
+
+// MarshalXML marshals the value being wrapped to XML. If the nullable is not
+// set no element is marshaled, and if it is null an element with the
+// attribute xsi:nil="true" is marshaled.
+func (n Nullable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.set {
+		return nil
+	}
+	if !n.IsNull() {
+		return n.value.MarshalXML(e, start)
+	}
+	return encodeXSINil(e, start)
+}
+
+// UnmarshalXML unmarshals the XML into a value wrapped by this nullable,
+// setting it. An element with the attribute xsi:nil="true" sets the nullable
+// to null.
+func (n *Nullable[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if isXSINil(start) {
+		*n = SetNullable(Empty[T]())
+		return d.Skip()
+	}
+	var o Optional[T]
+	err := o.UnmarshalXML(d, start)
+	if err != nil {
+		return err
+	}
+	*n = SetNullable(o)
+	return nil
+}
+
+// MarshalXMLAttr marshals the value being wrapped to an XML attribute, the
+// same as the optional it wraps. If the nullable is null or not set, no
+// attribute is marshaled.
+func (n Nullable[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return n.value.MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr unmarshals the XML attribute into a value wrapped by this
+// nullable, setting it. A missing attribute is not unmarshaled, leaving the
+// nullable not set, and an empty attribute sets the nullable to null, except
+// for strings.
+func (n *Nullable[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var o Optional[T]
+	err := o.UnmarshalXMLAttr(attr)
+	if err != nil {
+		return err
+	}
+	*n = SetNullable(o)
+	return nil
+}
+
+// MarshalText marshals the value being wrapped to text, the same as the
+// optional it wraps. If the nullable is null or not set, empty text is
+// marshaled.
+func (n Nullable[T]) MarshalText() (text []byte, err error) {
+	return n.value.MarshalText()
+}
+
+// AppendText appends the text form of the value being wrapped to b, the same
+// as MarshalText.
+func (n Nullable[T]) AppendText(b []byte) ([]byte, error) {
+	return n.value.AppendText(b)
+}
+
+// UnmarshalText unmarshals the text into a value wrapped by this nullable,
+// setting it. Empty text sets the nullable to null.
+func (n *Nullable[T]) UnmarshalText(text []byte) error {
+	var o Optional[T]
+	err := o.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*n = SetNullable(o)
+	return nil
+}
+
+// Scan implements sql.Scanner, setting the nullable. A SQL NULL sets the
+// nullable to null.
+func (n *Nullable[T]) Scan(src interface{}) error {
+	var o Optional[T]
+	err := o.Scan(src)
+	if err != nil {
+		return err
+	}
+	*n = SetNullable(o)
+	return nil
+}
+
+// Value implements driver.Valuer. If the nullable is null or not set, SQL NULL
+// is returned.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	return n.value.Value()
+}
+
+// MarshalYAML marshals the value being wrapped to YAML. If the nullable is
+// null or not set, null is marshaled. Use `omitempty` to omit the field when
+// the nullable is not set.
+func (n Nullable[T]) MarshalYAML() (interface{}, error) {
+	return n.value.MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the YAML into a value wrapped by this nullable,
+// setting it. A YAML null sets the nullable to null.
+func (n *Nullable[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var o Optional[T]
+	err := o.UnmarshalYAML(unmarshal)
+	if err != nil {
+		return err
+	}
+	*n = SetNullable(o)
+	return nil
+}
+
+// MarshalBinary marshals the nullable to its binary form, which is a byte for
+// whether it has been set, followed by the binary form of the optional it
+// wraps if it has been set.
+func (n Nullable[T]) MarshalBinary() (data []byte, err error) {
+	return n.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary form of the nullable to b, the same as
+// MarshalBinary.
+func (n Nullable[T]) AppendBinary(b []byte) ([]byte, error) {
+	if !n.set {
+		return append(b, binaryEmpty), nil
+	}
+	return n.value.AppendBinary(append(b, binaryPresent))
+}
+
+// UnmarshalBinary unmarshals the binary form of a nullable, as marshaled by
+// MarshalBinary, into this nullable.
+func (n *Nullable[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("optional: cannot unmarshal empty binary")
+	}
+	switch data[0] {
+	case binaryEmpty:
+		if len(data) != 1 {
+			return fmt.Errorf("optional: cannot unmarshal binary with a value into an unset nullable")
+		}
+		*n = Nullable[T]{}
+		return nil
+	case binaryPresent:
+		var o Optional[T]
+		err := o.UnmarshalBinary(data[1:])
+		if err != nil {
+			return err
+		}
+		*n = SetNullable(o)
+		return nil
+	}
+	return fmt.Errorf("optional: cannot unmarshal binary with unsupported version %d", data[0])
+}
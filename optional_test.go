@@ -0,0 +1,678 @@
+package optional
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOptionalIsPresent(t *testing.T) {
+	s := "ptr to string"
+	tests := []struct {
+		Optional          Optional[string]
+		ExpectedIsPresent bool
+	}{
+		{Empty[string](), false},
+		{Of(""), true},
+		{Of("string"), true},
+		{OfPtr((*string)(nil)), false},
+		{OfPtr(&s), true},
+	}
+
+	for _, test := range tests {
+		isPresent := test.Optional.IsPresent()
+
+		if isPresent != test.ExpectedIsPresent {
+			t.Errorf("%#v IsPresent got %#v, want %#v", test.Optional, isPresent, test.ExpectedIsPresent)
+		}
+	}
+}
+
+func TestOptionalGet(t *testing.T) {
+	s := "ptr to string"
+	tests := []struct {
+		Optional      Optional[string]
+		ExpectedValue string
+		ExpectedOk    bool
+	}{
+		{Empty[string](), "", false},
+		{Of(""), "", true},
+		{Of("string"), "string", true},
+		{OfPtr((*string)(nil)), "", false},
+		{OfPtr(&s), "ptr to string", true},
+	}
+
+	for _, test := range tests {
+		value, ok := test.Optional.Get()
+
+		if value != test.ExpectedValue || ok != test.ExpectedOk {
+			t.Errorf("%#v Get got %#v, %#v, want %#v, %#v", test.Optional, value, ok, test.ExpectedValue, test.ExpectedOk)
+		}
+	}
+}
+
+func TestOptionalElse(t *testing.T) {
+	const orElse = "orelse"
+	tests := []struct {
+		Optional       Optional[string]
+		ExpectedResult string
+	}{
+		{Empty[string](), orElse},
+		{Of(""), ""},
+		{Of("string"), "string"},
+	}
+
+	for _, test := range tests {
+		result := test.Optional.Else(orElse)
+
+		if result != test.ExpectedResult {
+			t.Errorf("%#v Else(%#v) got %#v, want %#v", test.Optional, orElse, result, test.ExpectedResult)
+		}
+
+		result = test.Optional.ElseFunc(func() string { return orElse })
+
+		if result != test.ExpectedResult {
+			t.Errorf("%#v ElseFunc(%#v) got %#v, want %#v", test.Optional, orElse, result, test.ExpectedResult)
+		}
+	}
+}
+
+func TestOptionalMarshal(t *testing.T) {
+	type s struct {
+		XMLName xml.Name      `json:"-" xml:"s"`
+		Int     Optional[int] `json:"int,omitzero" xml:"int"`
+	}
+	tests := []struct {
+		Value        s
+		ExpectedJSON string
+		ExpectedXML  string
+	}{
+		{s{Int: Empty[int]()}, `{}`, `<s></s>`},
+		{s{Int: Of(0)}, `{"int":0}`, `<s><int>0</int></s>`},
+		{s{Int: Of(1000)}, `{"int":1000}`, `<s><int>1000</int></s>`},
+	}
+
+	for _, test := range tests {
+		j, err := json.Marshal(test.Value)
+		if err != nil || string(j) != test.ExpectedJSON {
+			t.Errorf("%#v json.Marshal got %s, %v, want %s", test.Value, j, err, test.ExpectedJSON)
+		}
+		var fromJSON s
+		err = json.Unmarshal(j, &fromJSON)
+		if err != nil || fromJSON.Int.IsPresent() != test.Value.Int.IsPresent() || fromJSON.Int.ElseZero() != test.Value.Int.ElseZero() {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v", j, fromJSON.Int, err, test.Value.Int)
+		}
+
+		x, err := xml.Marshal(test.Value)
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Value, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.Int.IsPresent() != test.Value.Int.IsPresent() || fromXML.Int.ElseZero() != test.Value.Int.ElseZero() {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.Int, err, test.Value.Int)
+		}
+	}
+}
+
+func TestOptionalScan(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		Src              interface{}
+		Scan             func(src interface{}) (interface{}, error)
+		ExpectedOptional interface{}
+		ExpectedErr      bool
+	}{
+		{nil, unmarshalInto((*Optional[int8]).Scan), Empty[int8](), false},
+		{int64(127), unmarshalInto((*Optional[int8]).Scan), Of(int8(127)), false},
+		{int64(128), unmarshalInto((*Optional[int8]).Scan), Empty[int8](), true},
+		{int64(-1), unmarshalInto((*Optional[uint8]).Scan), Empty[uint8](), true},
+		{[]byte("255"), unmarshalInto((*Optional[uint8]).Scan), Of(uint8(255)), false},
+		{float64(1.5), unmarshalInto((*Optional[float32]).Scan), Of(float32(1.5)), false},
+		{int64(1), unmarshalInto((*Optional[bool]).Scan), Of(true), false},
+		{now, unmarshalInto((*Optional[time.Time]).Scan), Of(now), false},
+		{"2006-01-02T15:04:05.5Z", unmarshalInto((*Optional[time.Time]).Scan), Of(time.Date(2006, 1, 2, 15, 4, 5, 5e8, time.UTC)), false},
+		{[]byte("2006-01-02T15:04:05Z"), unmarshalInto((*Optional[time.Time]).Scan), Of(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)), false},
+		{"2006-01-02 15:04:05", unmarshalInto((*Optional[time.Time]).Scan), Empty[time.Time](), true},
+		{"2006-01-02T15:04:05Z", unmarshalInto((*Optional[string]).Scan), Of("2006-01-02T15:04:05Z"), false},
+	}
+
+	for _, test := range tests {
+		o, err := test.Scan(test.Src)
+
+		if (err != nil) != test.ExpectedErr || o != test.ExpectedOptional {
+			t.Errorf("%#v Scan got %#v, %v, want %#v, error %v", test.Src, o, err, test.ExpectedOptional, test.ExpectedErr)
+		}
+	}
+}
+
+func TestOptionalValue(t *testing.T) {
+	tests := []struct {
+		Optional      driver.Valuer
+		ExpectedValue driver.Value
+	}{
+		{Empty[int8](), nil},
+		{Of(int8(-1)), int64(-1)},
+		{Of(uint16(1)), int64(1)},
+		{Of(float32(1.5)), float64(1.5)},
+		{Of("string"), "string"},
+		{Of(uintptr(42)), int64(42)},
+		{EmptyUintptr(), nil},
+	}
+
+	for _, test := range tests {
+		value, err := test.Optional.Value()
+
+		if err != nil || value != test.ExpectedValue {
+			t.Errorf("%#v Value got %#v, %v, want %#v", test.Optional, value, err, test.ExpectedValue)
+		}
+	}
+}
+
+func TestOptionalValueOverflow(t *testing.T) {
+	o := Of(^uintptr(0))
+	if uint64(^uintptr(0)) <= math.MaxInt64 {
+		t.Skip("uintptr fits in int64")
+	}
+	value, err := o.Value()
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("%#v Value got %#v, %v, want ErrOverflow", o, value, err)
+	}
+}
+
+func TestOptionalText(t *testing.T) {
+	tests := []struct {
+		Optional     encoding.TextMarshaler
+		Unmarshal    func(text []byte) (interface{}, error)
+		ExpectedText string
+	}{
+		{Empty[int](), unmarshalInto((*Optional[int]).UnmarshalText), ``},
+		{Of(true), unmarshalInto((*Optional[bool]).UnmarshalText), `true`},
+		{Of(int8(-128)), unmarshalInto((*Optional[int8]).UnmarshalText), `-128`},
+		{Of(uint64(18446744073709551615)), unmarshalInto((*Optional[uint64]).UnmarshalText), `18446744073709551615`},
+		{Of(float32(2.1)), unmarshalInto((*Optional[float32]).UnmarshalText), `2.1`},
+		{Of(float64(1e21)), unmarshalInto((*Optional[float64]).UnmarshalText), `1e+21`},
+		{Of(complex64(1 + 2i)), unmarshalInto((*Optional[complex64]).UnmarshalText), `1+2i`},
+		{Of("string"), unmarshalInto((*Optional[string]).UnmarshalText), `string`},
+		{Of(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)), unmarshalInto((*Optional[time.Time]).UnmarshalText), `2006-01-02T15:04:05Z`},
+	}
+
+	for _, test := range tests {
+		text, err := test.Optional.MarshalText()
+
+		if err != nil || string(text) != test.ExpectedText {
+			t.Errorf("%#v MarshalText got %s, %v, want %s", test.Optional, text, err, test.ExpectedText)
+		}
+
+		o, err := test.Unmarshal(text)
+
+		if err != nil || o != test.Optional {
+			t.Errorf("%s UnmarshalText got %#v, %v, want %#v", text, o, err, test.Optional)
+		}
+	}
+}
+
+func TestOptionalUnmarshalTextError(t *testing.T) {
+	tests := []struct {
+		Text      string
+		Unmarshal func(text []byte) (interface{}, error)
+	}{
+		{`128`, unmarshalInto((*Optional[int8]).UnmarshalText)},
+		{`-1`, unmarshalInto((*Optional[uint]).UnmarshalText)},
+		{`1.5`, unmarshalInto((*Optional[int]).UnmarshalText)},
+		{`yes`, unmarshalInto((*Optional[bool]).UnmarshalText)},
+		{`2006-01-02`, unmarshalInto((*Optional[time.Time]).UnmarshalText)},
+	}
+
+	for _, test := range tests {
+		o, err := test.Unmarshal([]byte(test.Text))
+
+		if err == nil {
+			t.Errorf("%s UnmarshalText got %#v, want error", test.Text, o)
+		}
+	}
+}
+
+func TestOptionalXMLAttr(t *testing.T) {
+	type s struct {
+		XMLName xml.Name `xml:"s"`
+		Int     Int      `xml:"id,attr"`
+		String  String   `xml:"name,attr"`
+	}
+	tests := []struct {
+		XML      string
+		Expected s
+	}{
+		{`<s></s>`, s{}},
+		{`<s id="" name=""></s>`, s{String: OfString("")}},
+		{`<s id="0" name="a"></s>`, s{Int: OfInt(0), String: OfString("a")}},
+		{`<s id="-42"></s>`, s{Int: OfInt(-42)}},
+	}
+
+	for _, test := range tests {
+		var fromXML s
+		err := xml.Unmarshal([]byte(test.XML), &fromXML)
+		fromXML.XMLName = xml.Name{}
+		if err != nil || fromXML != test.Expected {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", test.XML, fromXML, err, test.Expected)
+		}
+	}
+
+	var fromXML s
+	err := xml.Unmarshal([]byte(`<s id="one"></s>`), &fromXML)
+	if err == nil {
+		t.Errorf("xml.Unmarshal got %#v, want error", fromXML)
+	}
+}
+
+func TestOptionalComplexJSON(t *testing.T) {
+	tests := []struct {
+		Data             string
+		ExpectedOptional Optional[complex128]
+	}{
+		{`null`, Empty[complex128]()},
+		{`"1+2i"`, Of(complex(1, 2))},
+		{`"(1+2i)"`, Of(complex(1, 2))},
+		{`"-2i"`, Of(complex(0, -2))},
+		{`1.5`, Of(complex(1.5, 0))},
+	}
+
+	for _, test := range tests {
+		var o Optional[complex128]
+		err := json.Unmarshal([]byte(test.Data), &o)
+
+		if err != nil || o != test.ExpectedOptional {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v", test.Data, o, err, test.ExpectedOptional)
+		}
+	}
+}
+
+func TestOptionalUnmarshalJSONLenient(t *testing.T) {
+	tests := []struct {
+		Lenient          bool
+		Data             string
+		Unmarshal        func(data []byte) (interface{}, error)
+		ExpectedOptional interface{}
+		ExpectedErr      bool
+	}{
+		{false, `"42"`, unmarshalInto((*Optional[int]).UnmarshalJSON), Empty[int](), true},
+		{false, `""`, unmarshalInto((*Optional[int]).UnmarshalJSON), Empty[int](), true},
+		{false, `"true"`, unmarshalInto((*Optional[bool]).UnmarshalJSON), Empty[bool](), true},
+		{false, `""`, unmarshalInto((*Optional[time.Time]).UnmarshalJSON), Empty[time.Time](), true},
+		{true, `42`, unmarshalInto(unmarshalLenientJSON[int]), Of(42), false},
+		{true, `"42"`, unmarshalInto(unmarshalLenientJSON[int]), Of(42), false},
+		{true, `"-1"`, unmarshalInto(unmarshalLenientJSON[uint8]), Empty[uint8](), true},
+		{true, `""`, unmarshalInto(unmarshalLenientJSON[int]), Empty[int](), false},
+		{true, `"2.5"`, unmarshalInto(unmarshalLenientJSON[float64]), Of(2.5), false},
+		{true, `"1e3"`, unmarshalInto(unmarshalLenientJSON[float64]), Of(1e3), false},
+		{true, `"Inf"`, unmarshalInto(unmarshalLenientJSON[float64]), Empty[float64](), true},
+		{true, `"0x1p-2"`, unmarshalInto(unmarshalLenientJSON[float64]), Empty[float64](), true},
+		{true, `" 42"`, unmarshalInto(unmarshalLenientJSON[int]), Empty[int](), true},
+		{true, `"true"`, unmarshalInto(unmarshalLenientJSON[bool]), Of(true), false},
+		{true, `"yes"`, unmarshalInto(unmarshalLenientJSON[bool]), Empty[bool](), true},
+		{true, `"1"`, unmarshalInto(unmarshalLenientJSON[bool]), Empty[bool](), true},
+		{true, `"t"`, unmarshalInto(unmarshalLenientJSON[bool]), Empty[bool](), true},
+		{true, `""`, unmarshalInto(unmarshalLenientJSON[bool]), Empty[bool](), false},
+		{true, `null`, unmarshalInto(unmarshalLenientJSON[bool]), Empty[bool](), false},
+		{true, `""`, unmarshalInto(unmarshalLenientJSON[time.Time]), Empty[time.Time](), false},
+		{true, `""`, unmarshalInto(unmarshalLenientJSON[string]), Of(""), false},
+		{true, `"1+2i"`, unmarshalInto(unmarshalLenientJSON[complex128]), Of(1 + 2i), false},
+	}
+
+	for _, test := range tests {
+		o, err := test.Unmarshal([]byte(test.Data))
+
+		if (err != nil) != test.ExpectedErr || o != test.ExpectedOptional {
+			t.Errorf("%s UnmarshalJSON with lenient %v got %#v, %v, want %#v, error %v", test.Data, test.Lenient, o, err, test.ExpectedOptional, test.ExpectedErr)
+		}
+	}
+}
+
+func TestOptionalJSONNonFinite(t *testing.T) {
+	tests := []json.Marshaler{
+		Of(math.NaN()),
+		Of(float32(math.Inf(1))),
+		Of(math.Inf(-1)),
+	}
+
+	for _, test := range tests {
+		data, err := test.MarshalJSON()
+		if err == nil {
+			t.Errorf("%#v MarshalJSON got %s, want error", test, data)
+		}
+	}
+
+	tests2 := []struct {
+		Unmarshal func(data []byte) (interface{}, error)
+		JSON      string
+	}{
+		{unmarshalInto((*Optional[float64]).UnmarshalJSON), `"NaN"`},
+		{unmarshalInto((*Optional[float64]).UnmarshalJSON), `"Infinity"`},
+		{unmarshalInto((*Optional[float32]).UnmarshalJSON), `"-Infinity"`},
+	}
+
+	for _, test := range tests2 {
+		o, err := test.Unmarshal([]byte(test.JSON))
+		if err == nil {
+			t.Errorf("%s UnmarshalJSON got %#v, want error", test.JSON, o)
+		}
+	}
+}
+
+func TestOptionalYAML(t *testing.T) {
+	tests := []struct {
+		Optional      interface{ MarshalYAML() (interface{}, error) }
+		ExpectedValue interface{}
+	}{
+		{Empty[int](), nil},
+		{Of(1), 1},
+		{Of(math.Inf(1)), math.Inf(1)},
+		{Of(complex(1, 2)), "1+2i"},
+		{Empty[complex128](), nil},
+		{OfInt(1), 1},
+		{EmptyInt(), nil},
+		{OfComplex64(1 + 2i), "1+2i"},
+	}
+
+	for _, test := range tests {
+		v, err := test.Optional.MarshalYAML()
+
+		if err != nil || v != test.ExpectedValue {
+			t.Errorf("%#v MarshalYAML got %#v, %v, want %#v", test.Optional, v, err, test.ExpectedValue)
+		}
+	}
+
+	unmarshalTests := []struct {
+		Value            interface{}
+		Unmarshal        func(func(interface{}) error) (interface{}, error)
+		ExpectedOptional interface{}
+	}{
+		{nil, unmarshalInto((*Optional[int]).UnmarshalYAML), Empty[int]()},
+		{1, unmarshalInto((*Optional[int]).UnmarshalYAML), Of(1)},
+		{nil, unmarshalInto((*Optional[complex128]).UnmarshalYAML), Empty[complex128]()},
+		{"1+2i", unmarshalInto((*Optional[complex128]).UnmarshalYAML), Of(complex(1, 2))},
+		{"", unmarshalInto((*Optional[complex128]).UnmarshalYAML), Empty[complex128]()},
+	}
+
+	for _, test := range unmarshalTests {
+		o, err := test.Unmarshal(yamlUnmarshal(test.Value))
+
+		if err != nil || o != test.ExpectedOptional {
+			t.Errorf("%#v UnmarshalYAML got %#v, %v, want %#v", test.Value, o, err, test.ExpectedOptional)
+		}
+	}
+}
+
+// yamlUnmarshal returns an unmarshal function like the one that YAML libraries
+// pass to UnmarshalYAML, which unmarshals the value, leaving pointers nil if
+// the value is nil, the same as a YAML null.
+func yamlUnmarshal(value interface{}) func(interface{}) error {
+	return func(out interface{}) error {
+		if value == nil {
+			return nil
+		}
+		rv := reflect.ValueOf(out).Elem()
+		for rv.Kind() == reflect.Ptr {
+			rv.Set(reflect.New(rv.Type().Elem()))
+			rv = rv.Elem()
+		}
+		v := reflect.ValueOf(value)
+		if !v.Type().ConvertibleTo(rv.Type()) {
+			return fmt.Errorf("cannot unmarshal %T into %s", value, rv.Type())
+		}
+		rv.Set(v.Convert(rv.Type()))
+		return nil
+	}
+}
+
+func TestOptionalBinary(t *testing.T) {
+	tests := []struct {
+		Optional       encoding.BinaryMarshaler
+		ExpectedBinary []byte
+		Unmarshal      func([]byte) (interface{}, error)
+	}{
+		{Empty[int](), []byte{0}, unmarshalInto((*Optional[int]).UnmarshalBinary)},
+		{Of(true), []byte{1, 1}, unmarshalInto((*Optional[bool]).UnmarshalBinary)},
+		{Of(false), []byte{1, 0}, unmarshalInto((*Optional[bool]).UnmarshalBinary)},
+		{Of(-1), []byte{1, 1}, unmarshalInto((*Optional[int]).UnmarshalBinary)},
+		{Of(int8(-128)), []byte{1, 0xff, 0x01}, unmarshalInto((*Optional[int8]).UnmarshalBinary)},
+		{Of(uint64(300)), []byte{1, 0xac, 0x02}, unmarshalInto((*Optional[uint64]).UnmarshalBinary)},
+		{Of(float32(1)), []byte{1, 0, 0, 0x80, 0x3f}, unmarshalInto((*Optional[float32]).UnmarshalBinary)},
+		{Of(1.0), []byte{1, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f}, unmarshalInto((*Optional[float64]).UnmarshalBinary)},
+		{Of(complex64(1 + 1i)), []byte{1, 0, 0, 0x80, 0x3f, 0, 0, 0x80, 0x3f}, unmarshalInto((*Optional[complex64]).UnmarshalBinary)},
+		{Of("hi"), []byte{1, 'h', 'i'}, unmarshalInto((*Optional[string]).UnmarshalBinary)},
+		{OfInt(-1), []byte{1, 1}, nil},
+		{EmptyInt(), []byte{0}, nil},
+		{OfUint8(255), []byte{1, 0xff, 0x01}, nil},
+		{OfString(""), []byte{1}, nil},
+		{OfComplex128(1i), []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f}, nil},
+	}
+
+	for _, test := range tests {
+		data, err := test.Optional.MarshalBinary()
+		if err != nil || !bytes.Equal(data, test.ExpectedBinary) {
+			t.Errorf("%#v MarshalBinary got %x, %v, want %x", test.Optional, data, err, test.ExpectedBinary)
+		}
+		if test.Unmarshal != nil {
+			o, err := test.Unmarshal(data)
+			if err != nil || o != test.Optional {
+				t.Errorf("%x UnmarshalBinary got %#v, %v, want %#v", data, o, err, test.Optional)
+			}
+		}
+	}
+
+	errorTests := []struct {
+		Data      []byte
+		Unmarshal func([]byte) (interface{}, error)
+	}{
+		{[]byte{1, 2}, unmarshalInto((*Optional[bool]).UnmarshalBinary)},
+		{[]byte{1, 0x80, 0x02}, unmarshalInto((*Optional[int8]).UnmarshalBinary)},
+		{[]byte{1, 0xff}, unmarshalInto((*Optional[int]).UnmarshalBinary)},
+		{[]byte{1, 1, 0}, unmarshalInto((*Optional[int]).UnmarshalBinary)},
+		{[]byte{1, 0, 0, 0}, unmarshalInto((*Optional[float32]).UnmarshalBinary)},
+		{[]byte{1}, unmarshalInto((*Optional[float64]).UnmarshalBinary)},
+		{[]byte{1, 0}, unmarshalInto((*Optional[struct{}]).UnmarshalBinary)},
+	}
+
+	for _, test := range errorTests {
+		o, err := test.Unmarshal(test.Data)
+		if err == nil {
+			t.Errorf("%x UnmarshalBinary got %#v, nil error, want error", test.Data, o)
+		}
+	}
+}
+
+func TestOptionalBinaryGob(t *testing.T) {
+	type point struct{ X, Y int }
+	o := Of(point{1, -2})
+	data, err := o.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%#v MarshalBinary got %x, %v", o, data, err)
+	}
+	var decoded Optional[point]
+	err = decoded.UnmarshalBinary(data)
+	if err != nil || decoded != o {
+		t.Errorf("%x UnmarshalBinary got %#v, %v, want %#v", data, decoded, err, o)
+	}
+
+	_, err = Of(struct{ a int }{1}).MarshalBinary()
+	if err == nil {
+		t.Errorf("MarshalBinary of a struct with no exported fields got nil error, want error")
+	}
+}
+
+func TestNullableBinary(t *testing.T) {
+	tests := []struct {
+		Nullable       NullableInt
+		ExpectedBinary []byte
+	}{
+		{UnsetNullable[int](), []byte{0}},
+		{SetNullable(EmptyInt()), []byte{1, 0}},
+		{OfNullable(-1), []byte{1, 1, 1}},
+	}
+
+	for _, test := range tests {
+		data, err := test.Nullable.MarshalBinary()
+		if err != nil || !bytes.Equal(data, test.ExpectedBinary) {
+			t.Errorf("%#v MarshalBinary got %x, %v, want %x", test.Nullable, data, err, test.ExpectedBinary)
+		}
+		var n NullableInt
+		err = n.UnmarshalBinary(data)
+		if err != nil || n != test.Nullable {
+			t.Errorf("%x UnmarshalBinary got %#v, %v, want %#v", data, n, err, test.Nullable)
+		}
+	}
+
+	for _, data := range [][]byte{{}, {0, 0}, {2}, {1}, {1, 2}} {
+		var n NullableInt
+		err := n.UnmarshalBinary(data)
+		if err == nil {
+			t.Errorf("%x UnmarshalBinary got %#v, nil error, want error", data, n)
+		}
+	}
+}
+
+func TestNullableXMLAttr(t *testing.T) {
+	type s struct {
+		XMLName xml.Name    `xml:"s"`
+		Int     NullableInt `xml:"n,attr"`
+	}
+	tests := []struct {
+		Nullable    NullableInt
+		ExpectedXML string
+		Unmarshaled NullableInt
+	}{
+		{UnsetNullable[int](), `<s></s>`, UnsetNullable[int]()},
+		{SetNullable(EmptyInt()), `<s></s>`, UnsetNullable[int]()},
+		{OfNullable(0), `<s n="0"></s>`, OfNullable(0)},
+		{OfNullable(-42), `<s n="-42"></s>`, OfNullable(-42)},
+	}
+
+	for _, test := range tests {
+		x, err := xml.Marshal(s{Int: test.Nullable})
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Nullable, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.Int != test.Unmarshaled {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.Int, err, test.Unmarshaled)
+		}
+	}
+
+	var fromXML s
+	err := xml.Unmarshal([]byte(`<s n=""></s>`), &fromXML)
+	if err != nil || fromXML.Int != SetNullable(EmptyInt()) {
+		t.Errorf("xml.Unmarshal got %#v, %v, want null", fromXML.Int, err)
+	}
+}
+
+func TestNullableText(t *testing.T) {
+	tests := []struct {
+		Nullable     NullableInt
+		ExpectedText string
+		Unmarshaled  NullableInt
+	}{
+		{UnsetNullable[int](), "", SetNullable(EmptyInt())},
+		{SetNullable(EmptyInt()), "", SetNullable(EmptyInt())},
+		{OfNullable(-42), "-42", OfNullable(-42)},
+	}
+
+	for _, test := range tests {
+		text, err := test.Nullable.MarshalText()
+		if err != nil || string(text) != test.ExpectedText {
+			t.Errorf("%#v MarshalText got %q, %v, want %q", test.Nullable, text, err, test.ExpectedText)
+		}
+		var n NullableInt
+		err = n.UnmarshalText(text)
+		if err != nil || n != test.Unmarshaled {
+			t.Errorf("%q UnmarshalText got %#v, %v, want %#v", text, n, err, test.Unmarshaled)
+		}
+	}
+}
+
+func TestNullableSQL(t *testing.T) {
+	tests := []struct {
+		Nullable      NullableInt
+		ExpectedValue driver.Value
+		Scanned       NullableInt
+	}{
+		{UnsetNullable[int](), nil, SetNullable(EmptyInt())},
+		{SetNullable(EmptyInt()), nil, SetNullable(EmptyInt())},
+		{OfNullable(-42), int64(-42), OfNullable(-42)},
+	}
+
+	for _, test := range tests {
+		value, err := test.Nullable.Value()
+		if err != nil || value != test.ExpectedValue {
+			t.Errorf("%#v Value got %#v, %v, want %#v", test.Nullable, value, err, test.ExpectedValue)
+		}
+		var n NullableInt
+		err = n.Scan(value)
+		if err != nil || n != test.Scanned {
+			t.Errorf("%#v Scan got %#v, %v, want %#v", value, n, err, test.Scanned)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type s struct {
+		Int     Int
+		String  String
+		Float64 Float64
+		Time    Time
+		Generic Optional[uint16]
+		Struct  Optional[struct{ A int }]
+		Unset   NullableInt
+		Null    NullableInt
+		Set     NullableString
+	}
+	tests := []s{
+		{},
+		{Int: OfInt(0), String: OfString(""), Float64: OfFloat64(0), Time: OfTime(time.Time{})},
+		{Int: OfInt(-42), String: OfString("string"), Float64: OfFloat64(math.Inf(-1)), Time: OfTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)), Generic: Of(uint16(65535)), Struct: Of(struct{ A int }{1}), Null: SetNullable(EmptyInt()), Set: OfNullable("string")},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(test)
+		if err != nil {
+			t.Errorf("%#v gob Encode got %v", test, err)
+			continue
+		}
+		var decoded s
+		err = gob.NewDecoder(&buf).Decode(&decoded)
+		if err != nil || !reflect.DeepEqual(decoded, test) {
+			t.Errorf("%#v gob Decode got %#v, %v, want %#v", test, decoded, err, test)
+		}
+	}
+}
+
+// unmarshalInto returns a function that unmarshals into a new optional with the
+// unmarshal function, such as the method expression
+// (*Optional[int]).UnmarshalJSON, for tables of tests of optionals of different
+// types.
+func unmarshalInto[T, D any](unmarshal func(*Optional[T], D) error) func(D) (interface{}, error) {
+	return func(data D) (interface{}, error) {
+		var o Optional[T]
+		err := unmarshal(&o, data)
+		return o, err
+	}
+}
+
+// unmarshalLenientJSON unmarshals the JSON into the optional through Lenient.
+func unmarshalLenientJSON[T any](o *Optional[T], data []byte) error {
+	var l Lenient[T]
+	err := json.Unmarshal(data, &l)
+	*o = l.Optional
+	return err
+}
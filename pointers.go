@@ -0,0 +1,146 @@
+package optional
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FromPointers copies the fields of the struct src that have pointer types,
+// such as *int, into the fields of the struct pointed to by dst that have the
+// same names and the matching optional types, such as Int or Optional[int]. A
+// nil pointer is copied as an empty optional. The src may be a struct or a
+// pointer to a struct.
+//
+// Fields that are structs in both, or a pointer to a struct in src and a
+// struct in dst, are copied the same way. Fields with the same type in both
+// are copied as they are, and other pointers in src are copied as the value
+// they point to, or the zero value if they are nil. Fields that are in only
+// one of the structs are not copied, and an error is returned if fields with
+// the same name cannot be copied.
+func FromPointers(dst, src any) error {
+	d, s, err := structValues(dst, src)
+	if err != nil {
+		return err
+	}
+	return copyStruct(d, s, "", false)
+}
+
+// ToPointers copies the fields of the struct src that have optional types,
+// such as Int or Optional[int], into the fields of the struct pointed to by
+// dst that have the same names and the matching pointer types, such as *int.
+// An empty optional is copied as a nil pointer, and other optionals as a
+// pointer to a copy of their value. The src may be a struct or a pointer to a
+// struct.
+//
+// Fields that are structs in both, or a struct in src and a pointer to a
+// struct in dst, are copied the same way. Fields with the same type in both
+// are copied as they are, and other fields are copied as a pointer to a copy
+// of them if dst has a pointer to their type. Fields that are in only one of
+// the structs are not copied, and an error is returned if fields with the
+// same name cannot be copied.
+func ToPointers(dst, src any) error {
+	d, s, err := structValues(dst, src)
+	if err != nil {
+		return err
+	}
+	return copyStruct(d, s, "", true)
+}
+
+func structValues(dst, src any) (d, s reflect.Value, err error) {
+	d = reflect.ValueOf(dst)
+	if d.Kind() != reflect.Ptr || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return d, s, fmt.Errorf("optional: cannot copy into %T, it must be a non-nil pointer to a struct", dst)
+	}
+	s = reflect.ValueOf(src)
+	if s.Kind() == reflect.Ptr && !s.IsNil() {
+		s = s.Elem()
+	}
+	if s.Kind() != reflect.Struct {
+		return d, s, fmt.Errorf("optional: cannot copy from %T, it must be a struct or a non-nil pointer to a struct", src)
+	}
+	return d.Elem(), s, nil
+}
+
+func copyStruct(dst, src reflect.Value, path string, toPointers bool) error {
+	for i := 0; i < dst.NumField(); i++ {
+		df := dst.Type().Field(i)
+		if !df.IsExported() {
+			continue
+		}
+		sf, ok := src.Type().FieldByName(df.Name)
+		if !ok || !sf.IsExported() {
+			continue
+		}
+		sv, err := src.FieldByIndexErr(sf.Index)
+		if err != nil {
+			// The field is promoted through a nil embedded pointer.
+			continue
+		}
+		err = copyField(dst.Field(i), sv, path+df.Name, toPointers)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyField(dst, src reflect.Value, path string, toPointers bool) error {
+	dt, st := dst.Type(), src.Type()
+	switch {
+	case !toPointers && optionalPtrType(dt) == st:
+		dst.Addr().MethodByName("SetPtr").Call([]reflect.Value{src})
+	case toPointers && optionalPtrType(st) == dt:
+		dst.Set(src.MethodByName("Ptr").Call(nil)[0])
+	case st.AssignableTo(dt):
+		dst.Set(src)
+	case !toPointers && st.Kind() == reflect.Ptr && st.Elem() == dt:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dt))
+		} else {
+			dst.Set(src.Elem())
+		}
+	case toPointers && dt.Kind() == reflect.Ptr && dt.Elem() == st:
+		p := reflect.New(st)
+		p.Elem().Set(src)
+		dst.Set(p)
+	case isPlainStruct(dt) && isPlainStruct(st):
+		return copyStruct(dst, src, path+".", toPointers)
+	case !toPointers && isPlainStruct(dt) && st.Kind() == reflect.Ptr && isPlainStruct(st.Elem()):
+		dst.Set(reflect.Zero(dt))
+		if src.IsNil() {
+			return nil
+		}
+		return copyStruct(dst, src.Elem(), path+".", toPointers)
+	case toPointers && dt.Kind() == reflect.Ptr && isPlainStruct(dt.Elem()) && isPlainStruct(st):
+		p := reflect.New(dt.Elem())
+		err := copyStruct(p.Elem(), src, path+".", toPointers)
+		if err != nil {
+			return err
+		}
+		dst.Set(p)
+	default:
+		return fmt.Errorf("optional: cannot copy field %s of type %s to %s", path, st, dt)
+	}
+	return nil
+}
+
+// optionalPtrType returns the pointer type returned by the Ptr method of the
+// optional type t, or nil if t is not an optional type. Optional types have a
+// Ptr method that returns a pointer, and a SetPtr method that accepts it.
+func optionalPtrType(t reflect.Type) reflect.Type {
+	ptr, ok := t.MethodByName("Ptr")
+	if !ok || ptr.Type.NumIn() != 1 || ptr.Type.NumOut() != 1 || ptr.Type.Out(0).Kind() != reflect.Ptr {
+		return nil
+	}
+	pt := ptr.Type.Out(0)
+	setPtr, ok := reflect.PointerTo(t).MethodByName("SetPtr")
+	if !ok || setPtr.Type.NumIn() != 2 || setPtr.Type.In(1) != pt || setPtr.Type.NumOut() != 0 {
+		return nil
+	}
+	return pt
+}
+
+// isPlainStruct returns true if t is a struct type that is not an optional.
+func isPlainStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && optionalPtrType(t) == nil
+}
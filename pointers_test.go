@@ -0,0 +1,134 @@
+package optional
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type pointersAddress struct {
+	Street *string
+	Zip    *int
+}
+
+type pointersUser struct {
+	ID       string
+	Name     *string
+	Age      *int
+	Admin    *bool
+	Created  *time.Time
+	Updated  *time.Time
+	Score    *float64
+	Address  *pointersAddress
+	Previous pointersAddress
+	Ignored  *string
+	private  *string
+}
+
+type optionalsAddress struct {
+	Street String
+	Zip    Int
+}
+
+type optionalsUser struct {
+	ID       string
+	Name     String
+	Age      Int
+	Admin    Optional[bool]
+	Created  Time
+	Updated  UnixTime
+	Score    Float64
+	Address  optionalsAddress
+	Previous optionalsAddress
+	Other    String
+	private  String
+}
+
+func TestPointers(t *testing.T) {
+	name, age, admin, street := "name", 42, false, "street"
+	created := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	pointers := pointersUser{
+		ID:       "id",
+		Name:     &name,
+		Age:      &age,
+		Admin:    &admin,
+		Created:  &created,
+		Address:  &pointersAddress{Street: &street},
+		Previous: pointersAddress{Zip: &age},
+	}
+	optionals := optionalsUser{
+		ID:       "id",
+		Name:     OfString("name"),
+		Age:      OfInt(42),
+		Admin:    Of(false),
+		Created:  OfTime(created),
+		Address:  optionalsAddress{Street: OfString("street")},
+		Previous: optionalsAddress{Zip: OfInt(42)},
+	}
+
+	from := optionalsUser{Other: OfString("other"), Score: OfFloat64(1)}
+	err := FromPointers(&from, pointers)
+	want := optionals
+	want.Other = OfString("other")
+	if err != nil || from != want {
+		t.Errorf("FromPointers got %#v, %v, want %#v", from, err, want)
+	}
+
+	var to pointersUser
+	err = ToPointers(&to, &optionals)
+	if err != nil || !reflect.DeepEqual(to, pointers) {
+		t.Errorf("ToPointers got %#v, %v, want %#v", to, err, pointers)
+	}
+	if to.Name == pointers.Name {
+		t.Errorf("ToPointers got the same pointer, want a pointer to a copy")
+	}
+
+	var fromNil optionalsUser
+	err = FromPointers(&fromNil, pointersUser{})
+	if err != nil || fromNil != (optionalsUser{}) {
+		t.Errorf("FromPointers got %#v, %v, want empty optionals", fromNil, err)
+	}
+}
+
+func TestPointersErrors(t *testing.T) {
+	type mismatch struct {
+		Name Int
+	}
+	tests := []struct {
+		Func func() error
+	}{
+		{func() error { return FromPointers(optionalsUser{}, pointersUser{}) }},
+		{func() error { return FromPointers((*optionalsUser)(nil), pointersUser{}) }},
+		{func() error { return FromPointers(&optionalsUser{}, 1) }},
+		{func() error { return FromPointers(&mismatch{}, pointersUser{}) }},
+		{func() error { return ToPointers(&pointersUser{}, mismatch{}) }},
+	}
+
+	for i, test := range tests {
+		if err := test.Func(); err == nil {
+			t.Errorf("test %d got nil error, want error", i)
+		}
+	}
+}
+
+func TestPtr(t *testing.T) {
+	if p := EmptyInt().Ptr(); p != nil {
+		t.Errorf("EmptyInt().Ptr() got %v, want nil", p)
+	}
+	o := OfInt(1)
+	p := o.Ptr()
+	*p = 2
+	if p2 := o.Ptr(); *p2 != 1 || p2 == p {
+		t.Errorf("OfInt(1).Ptr() got %v, want a new pointer to 1", *p2)
+	}
+
+	var g Optional[string]
+	g.SetPtr(Of("s").Ptr())
+	if g != Of("s") {
+		t.Errorf("SetPtr got %#v, want %#v", g, Of("s"))
+	}
+	g.SetPtr(nil)
+	if g.IsPresent() {
+		t.Errorf("SetPtr(nil) got %#v, want empty", g)
+	}
+}
@@ -0,0 +1,58 @@
+package optional
+
+import (
+	"encoding"
+	"encoding/json"
+)
+
+// Quoted wraps a numeric or bool optional and marshals it to JSON as a string,
+// such as "42" or "true". The `json:",string"` struct tag option has no effect
+// on optionals because they implement json.Marshaler. Unmarshaling accepts
+// quoted and unquoted values.
+type Quoted[T Number | bool] struct {
+	Optional[T]
+}
+
+// MarshalJSON marshals the value being wrapped to a JSON string. If there is
+// no value being wrapped, null is marshaled.
+func (q Quoted[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(q.Optional)
+}
+
+// UnmarshalJSON unmarshals the JSON string, or unquoted value, into a value
+// wrapped by this optional. A JSON null or empty string unmarshals into an
+// empty optional.
+func (q *Quoted[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(&q.Optional, data)
+}
+
+type textOptional interface {
+	IsPresent() bool
+	encoding.TextAppender
+}
+
+func marshalJSONString(o textOptional) ([]byte, error) {
+	if !o.IsPresent() {
+		return []byte("null"), nil
+	}
+	b, err := o.AppendText([]byte{'"'})
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '"'), nil
+}
+
+func unmarshalJSONString(o encoding.TextUnmarshaler, data []byte) error {
+	if string(data) == "null" {
+		return o.UnmarshalText(nil)
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return o.UnmarshalText(data)
+}
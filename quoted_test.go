@@ -0,0 +1,55 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestQuotedMarshalJSON(t *testing.T) {
+	tests := []struct {
+		Optional     json.Marshaler
+		ExpectedJSON string
+	}{
+		{Quoted[bool]{EmptyBool()}, `null`},
+		{Quoted[bool]{OfBool(false)}, `"false"`},
+		{Quoted[float64]{OfFloat64(2.5)}, `"2.5"`},
+		{Quoted[int64]{OfInt64(-9223372036854775808)}, `"-9223372036854775808"`},
+		{Quoted[uint64]{OfUint64(18446744073709551615)}, `"18446744073709551615"`},
+		{Quoted[uint8]{OfUint8(255)}, `"255"`},
+		{Quoted[rune]{OfRune('a')}, `"97"`},
+	}
+
+	for _, test := range tests {
+		data, err := test.Optional.MarshalJSON()
+
+		if err != nil || string(data) != test.ExpectedJSON {
+			t.Errorf("%#v MarshalJSON got %s, %v, want %s", test.Optional, data, err, test.ExpectedJSON)
+		}
+	}
+}
+
+func TestQuotedUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		Data             string
+		ExpectedOptional Quoted[int64]
+		ExpectedErr      bool
+	}{
+		{`null`, Quoted[int64]{EmptyInt64()}, false},
+		{`""`, Quoted[int64]{EmptyInt64()}, false},
+		{`"42"`, Quoted[int64]{OfInt64(42)}, false},
+		{`42`, Quoted[int64]{OfInt64(42)}, false},
+		{`"-9223372036854775808"`, Quoted[int64]{OfInt64(-9223372036854775808)}, false},
+		{`"9223372036854775808"`, Quoted[int64]{}, true},
+		{`"4.2"`, Quoted[int64]{}, true},
+		{`true`, Quoted[int64]{}, true},
+	}
+
+	for _, test := range tests {
+		var o Quoted[int64]
+		err := json.Unmarshal([]byte(test.Data), &o)
+
+		if (err != nil) != test.ExpectedErr || o != test.ExpectedOptional {
+			t.Errorf("%s UnmarshalJSON got %#v, %v, want %#v, error %v", test.Data, o, err, test.ExpectedOptional, test.ExpectedErr)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package optional
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"unicode/utf8"
+)
+
+// RuneChar wraps a Rune and marshals it as a string containing the one
+// character, instead of as the number of its code point.
+type RuneChar struct {
+	Rune
+}
+
+// AppendText appends the character of the value being wrapped to b. If there
+// is no value being wrapped, nothing is appended.
+func (o RuneChar) AppendText(b []byte) ([]byte, error) {
+	r, ok := o.Get()
+	if !ok {
+		return b, nil
+	}
+	return utf8.AppendRune(b, r), nil
+}
+
+// MarshalText marshals the value being wrapped to its character. If there is
+// no value being wrapped, empty text is marshaled.
+func (o RuneChar) MarshalText() ([]byte, error) {
+	return o.AppendText(nil)
+}
+
+// UnmarshalText unmarshals the text, which must be exactly one character,
+// into a value wrapped by this optional. Empty text unmarshals into an empty
+// optional.
+func (o *RuneChar) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		o.Rune = EmptyRune()
+		return nil
+	}
+	r, size := utf8.DecodeRune(text)
+	if r == utf8.RuneError && size <= 1 || size != len(text) {
+		return fmt.Errorf("optional: cannot unmarshal %q into a rune, it must be exactly one character", text)
+	}
+	o.Rune = OfRune(r)
+	return nil
+}
+
+// String returns the character of the wrapped value, or the character of the
+// zero value if there is no value wrapped by this optional.
+func (o RuneChar) String() string {
+	return string(o.ElseZero())
+}
+
+// MarshalJSON marshals the value being wrapped to a JSON string containing
+// its character. If there is no value being wrapped, null is marshaled.
+func (o RuneChar) MarshalJSON() ([]byte, error) {
+	return marshalJSONText(o, true)
+}
+
+// UnmarshalJSON unmarshals the JSON string, which must be exactly one
+// character, or the JSON number of a code point, into a value wrapped by this
+// optional. A JSON null unmarshals into an empty optional.
+func (o *RuneChar) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+		return o.UnmarshalText([]byte(s))
+	}
+	var n Rune
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	if r, ok := n.Get(); ok && !utf8.ValidRune(r) {
+		return fmt.Errorf("optional: cannot unmarshal %d into a rune, it is not a valid code point", r)
+	}
+	o.Rune = n
+	return nil
+}
+
+// MarshalYAML marshals the value being wrapped to a YAML string containing
+// its character. If there is no value being wrapped, null is marshaled.
+func (o RuneChar) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(o)
+}
+
+// UnmarshalYAML unmarshals the YAML string, which must be exactly one
+// character, into a value wrapped by this optional. A YAML null unmarshals
+// into an empty optional.
+func (o *RuneChar) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, o)
+}
+
+// MarshalXML marshals the value being wrapped to XML the same as MarshalText.
+func (o RuneChar) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLText(e, start, o, o.Rune)
+}
+
+// UnmarshalXML unmarshals the XML into a value wrapped by this optional the
+// same as UnmarshalText.
+func (o *RuneChar) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	err := d.DecodeElement(&s, &start)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalText([]byte(s))
+}
+
+// MarshalXMLAttr marshals the value being wrapped to an XML attribute the
+// same as MarshalText. If there is no value being wrapped, no attribute is
+// marshaled.
+func (o RuneChar) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttrText(name, o)
+}
+
+// UnmarshalXMLAttr unmarshals the XML attribute into a value wrapped by this
+// optional the same as UnmarshalText.
+func (o *RuneChar) UnmarshalXMLAttr(attr xml.Attr) error {
+	return o.UnmarshalText([]byte(attr.Value))
+}
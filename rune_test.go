@@ -0,0 +1,130 @@
+package optional
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+func TestRuneCharMarshal(t *testing.T) {
+	type s struct {
+		XMLName xml.Name `json:"-" xml:"s"`
+		Rune    RuneChar `json:"r" xml:"r"`
+	}
+	tests := []struct {
+		Rune         RuneChar
+		ExpectedJSON string
+		ExpectedXML  string
+	}{
+		{RuneChar{EmptyRune()}, `{"r":null}`, `<s></s>`},
+		{RuneChar{OfRune('a')}, `{"r":"a"}`, `<s><r>a</r></s>`},
+		{RuneChar{OfRune(' ')}, `{"r":" "}`, `<s><r> </r></s>`},
+		{RuneChar{OfRune('世')}, `{"r":"世"}`, `<s><r>世</r></s>`},
+		{RuneChar{OfRune(0)}, `{"r":"\u0000"}`, ``},
+	}
+
+	for _, test := range tests {
+		j, err := json.Marshal(s{Rune: test.Rune})
+		if err != nil || string(j) != test.ExpectedJSON {
+			t.Errorf("%#v json.Marshal got %s, %v, want %s", test.Rune, j, err, test.ExpectedJSON)
+		}
+		var fromJSON s
+		err = json.Unmarshal(j, &fromJSON)
+		if err != nil || fromJSON.Rune != test.Rune {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v", j, fromJSON.Rune, err, test.Rune)
+		}
+
+		if test.ExpectedXML == "" {
+			continue
+		}
+		x, err := xml.Marshal(s{Rune: test.Rune})
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Rune, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.Rune != test.Rune {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.Rune, err, test.Rune)
+		}
+	}
+}
+
+func TestRuneCharUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		Data             string
+		ExpectedOptional RuneChar
+		ExpectedErr      bool
+	}{
+		{`null`, RuneChar{EmptyRune()}, false},
+		{`""`, RuneChar{EmptyRune()}, false},
+		{`"a"`, RuneChar{OfRune('a')}, false},
+		{`97`, RuneChar{OfRune('a')}, false},
+		{`19990`, RuneChar{OfRune('世')}, false},
+		{`"ab"`, RuneChar{}, true},
+		{`"\ud800"`, RuneChar{OfRune('�')}, false},
+		{`55296`, RuneChar{}, true},
+		{`-1`, RuneChar{}, true},
+		{`true`, RuneChar{}, true},
+	}
+
+	for _, test := range tests {
+		var o RuneChar
+		err := json.Unmarshal([]byte(test.Data), &o)
+
+		if (err != nil) != test.ExpectedErr || o != test.ExpectedOptional {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v, error %v", test.Data, o, err, test.ExpectedOptional, test.ExpectedErr)
+		}
+	}
+}
+
+func TestRuneCharUnmarshalText(t *testing.T) {
+	tests := []struct {
+		Text        string
+		ExpectedErr bool
+	}{
+		{"a", false},
+		{"世", false},
+		{"ab", true},
+		{"\xff", true},
+		{"a\xff", true},
+	}
+
+	for _, test := range tests {
+		var o RuneChar
+		err := o.UnmarshalText([]byte(test.Text))
+
+		if (err != nil) != test.ExpectedErr {
+			t.Errorf("%q UnmarshalText got %#v, %v, want error %v", test.Text, o, err, test.ExpectedErr)
+		}
+	}
+}
+
+func TestRuneCharYAML(t *testing.T) {
+	v, err := RuneChar{OfRune('世')}.MarshalYAML()
+	if err != nil || v != "世" {
+		t.Errorf("MarshalYAML got %#v, %v, want %#v", v, err, "世")
+	}
+	v, err = RuneChar{EmptyRune()}.MarshalYAML()
+	if err != nil || v != nil {
+		t.Errorf("MarshalYAML got %#v, %v, want nil", v, err)
+	}
+
+	tests := []struct {
+		Value            interface{}
+		ExpectedOptional RuneChar
+		ExpectedErr      bool
+	}{
+		{nil, RuneChar{EmptyRune()}, false},
+		{"a", RuneChar{OfRune('a')}, false},
+		{"ab", RuneChar{EmptyRune()}, true},
+	}
+
+	for _, test := range tests {
+		var o RuneChar
+		err := o.UnmarshalYAML(yamlUnmarshal(test.Value))
+
+		if (err != nil) != test.ExpectedErr || o != test.ExpectedOptional {
+			t.Errorf("%#v UnmarshalYAML got %#v, %v, want %#v, error %v", test.Value, o, err, test.ExpectedOptional, test.ExpectedErr)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package optional
+
+import "database/sql"
+
+// The functions in this file convert between optionals and the database/sql
+// Null types for each optional that has a matching type. Every optional can
+// also be converted to and from sql.Null, such as with OfSQLNullInt and
+// Int.ToSQLNull.
+
+// OfNullBool wraps the value of the sql.NullBool in an optional, or returns an
+// empty optional if the sql.NullBool is not valid.
+func OfNullBool(n sql.NullBool) Bool {
+	if !n.Valid {
+		return EmptyBool()
+	}
+	return OfBool(n.Bool)
+}
+
+// ToNullBool returns the value wrapped by the optional as a sql.NullBool,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullBool(o Bool) sql.NullBool {
+	v, ok := o.Get()
+	return sql.NullBool{Bool: v, Valid: ok}
+}
+
+// OfNullByte wraps the value of the sql.NullByte in an optional, or returns an
+// empty optional if the sql.NullByte is not valid.
+func OfNullByte(n sql.NullByte) Byte {
+	if !n.Valid {
+		return EmptyByte()
+	}
+	return OfByte(n.Byte)
+}
+
+// ToNullByte returns the value wrapped by the optional as a sql.NullByte,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullByte(o Byte) sql.NullByte {
+	v, ok := o.Get()
+	return sql.NullByte{Byte: v, Valid: ok}
+}
+
+// OfNullFloat64 wraps the value of the sql.NullFloat64 in an optional, or
+// returns an empty optional if the sql.NullFloat64 is not valid.
+func OfNullFloat64(n sql.NullFloat64) Float64 {
+	if !n.Valid {
+		return EmptyFloat64()
+	}
+	return OfFloat64(n.Float64)
+}
+
+// ToNullFloat64 returns the value wrapped by the optional as a sql.NullFloat64,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullFloat64(o Float64) sql.NullFloat64 {
+	v, ok := o.Get()
+	return sql.NullFloat64{Float64: v, Valid: ok}
+}
+
+// OfNullInt16 wraps the value of the sql.NullInt16 in an optional, or returns
+// an empty optional if the sql.NullInt16 is not valid.
+func OfNullInt16(n sql.NullInt16) Int16 {
+	if !n.Valid {
+		return EmptyInt16()
+	}
+	return OfInt16(n.Int16)
+}
+
+// ToNullInt16 returns the value wrapped by the optional as a sql.NullInt16,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullInt16(o Int16) sql.NullInt16 {
+	v, ok := o.Get()
+	return sql.NullInt16{Int16: v, Valid: ok}
+}
+
+// OfNullInt32 wraps the value of the sql.NullInt32 in an optional, or returns
+// an empty optional if the sql.NullInt32 is not valid.
+func OfNullInt32(n sql.NullInt32) Int32 {
+	if !n.Valid {
+		return EmptyInt32()
+	}
+	return OfInt32(n.Int32)
+}
+
+// ToNullInt32 returns the value wrapped by the optional as a sql.NullInt32,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullInt32(o Int32) sql.NullInt32 {
+	v, ok := o.Get()
+	return sql.NullInt32{Int32: v, Valid: ok}
+}
+
+// OfNullInt64 wraps the value of the sql.NullInt64 in an optional, or returns
+// an empty optional if the sql.NullInt64 is not valid.
+func OfNullInt64(n sql.NullInt64) Int64 {
+	if !n.Valid {
+		return EmptyInt64()
+	}
+	return OfInt64(n.Int64)
+}
+
+// ToNullInt64 returns the value wrapped by the optional as a sql.NullInt64,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullInt64(o Int64) sql.NullInt64 {
+	v, ok := o.Get()
+	return sql.NullInt64{Int64: v, Valid: ok}
+}
+
+// OfNullString wraps the value of the sql.NullString in an optional, or returns
+// an empty optional if the sql.NullString is not valid.
+func OfNullString(n sql.NullString) String {
+	if !n.Valid {
+		return EmptyString()
+	}
+	return OfString(n.String)
+}
+
+// ToNullString returns the value wrapped by the optional as a sql.NullString,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullString(o String) sql.NullString {
+	v, ok := o.Get()
+	return sql.NullString{String: v, Valid: ok}
+}
+
+// OfNullTime wraps the value of the sql.NullTime in an optional, or returns an
+// empty optional if the sql.NullTime is not valid.
+func OfNullTime(n sql.NullTime) Time {
+	if !n.Valid {
+		return EmptyTime()
+	}
+	return OfTime(n.Time)
+}
+
+// ToNullTime returns the value wrapped by the optional as a sql.NullTime,
+// which is not valid if there is no value wrapped by the optional.
+func ToNullTime(o Time) sql.NullTime {
+	v, ok := o.Get()
+	return sql.NullTime{Time: v, Valid: ok}
+}
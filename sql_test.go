@@ -0,0 +1,73 @@
+package optional
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestOfNull(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		Optional         interface{}
+		ExpectedOptional interface{}
+	}{
+		{OfNullBool(sql.NullBool{}), EmptyBool()},
+		{OfNullBool(sql.NullBool{Bool: true, Valid: true}), OfBool(true)},
+		{OfNullByte(sql.NullByte{}), EmptyByte()},
+		{OfNullByte(sql.NullByte{Byte: 1, Valid: true}), OfByte(1)},
+		{OfNullFloat64(sql.NullFloat64{}), EmptyFloat64()},
+		{OfNullFloat64(sql.NullFloat64{Float64: 1.5, Valid: true}), OfFloat64(1.5)},
+		{OfNullInt16(sql.NullInt16{}), EmptyInt16()},
+		{OfNullInt16(sql.NullInt16{Int16: 1, Valid: true}), OfInt16(1)},
+		{OfNullInt32(sql.NullInt32{}), EmptyInt32()},
+		{OfNullInt32(sql.NullInt32{Int32: 1, Valid: true}), OfInt32(1)},
+		{OfNullInt64(sql.NullInt64{}), EmptyInt64()},
+		{OfNullInt64(sql.NullInt64{Int64: 1, Valid: true}), OfInt64(1)},
+		{OfNullString(sql.NullString{}), EmptyString()},
+		{OfNullString(sql.NullString{String: "", Valid: true}), OfString("")},
+		{OfNullTime(sql.NullTime{}), EmptyTime()},
+		{OfNullTime(sql.NullTime{Time: now, Valid: true}), OfTime(now)},
+		{OfSQLNullUint(sql.Null[uint]{}), EmptyUint()},
+		{OfSQLNullUint(sql.Null[uint]{V: 1, Valid: true}), OfUint(1)},
+	}
+
+	for _, test := range tests {
+		if test.Optional != test.ExpectedOptional {
+			t.Errorf("got %#v, want %#v", test.Optional, test.ExpectedOptional)
+		}
+	}
+}
+
+func TestToNull(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		Null         interface{}
+		ExpectedNull interface{}
+	}{
+		{ToNullBool(EmptyBool()), sql.NullBool{}},
+		{ToNullBool(OfBool(true)), sql.NullBool{Bool: true, Valid: true}},
+		{ToNullByte(EmptyByte()), sql.NullByte{}},
+		{ToNullByte(OfByte(1)), sql.NullByte{Byte: 1, Valid: true}},
+		{ToNullFloat64(EmptyFloat64()), sql.NullFloat64{}},
+		{ToNullFloat64(OfFloat64(1.5)), sql.NullFloat64{Float64: 1.5, Valid: true}},
+		{ToNullInt16(EmptyInt16()), sql.NullInt16{}},
+		{ToNullInt16(OfInt16(1)), sql.NullInt16{Int16: 1, Valid: true}},
+		{ToNullInt32(EmptyInt32()), sql.NullInt32{}},
+		{ToNullInt32(OfInt32(1)), sql.NullInt32{Int32: 1, Valid: true}},
+		{ToNullInt64(EmptyInt64()), sql.NullInt64{}},
+		{ToNullInt64(OfInt64(1)), sql.NullInt64{Int64: 1, Valid: true}},
+		{ToNullString(EmptyString()), sql.NullString{}},
+		{ToNullString(OfString("")), sql.NullString{String: "", Valid: true}},
+		{ToNullTime(EmptyTime()), sql.NullTime{}},
+		{ToNullTime(OfTime(now)), sql.NullTime{Time: now, Valid: true}},
+		{EmptyUint().ToSQLNull(), sql.Null[uint]{}},
+		{OfUint(1).ToSQLNull(), sql.Null[uint]{V: 1, Valid: true}},
+	}
+
+	for _, test := range tests {
+		if test.Null != test.ExpectedNull {
+			t.Errorf("got %#v, want %#v", test.Null, test.ExpectedNull)
+		}
+	}
+}
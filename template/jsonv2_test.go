@@ -0,0 +1,67 @@
+//go:build goexperiment.jsonv2
+
+package template
+
+import (
+	jsonv2 "encoding/json/v2"
+	"testing"
+)
+
+// The methods tested here are generated into optional_jsonv2_test.go by
+// jsonv2gen, the same as they are generated for types from the template.
+var (
+	_ jsonv2.MarshalerTo     = Optional{}
+	_ jsonv2.UnmarshalerFrom = (*Optional)(nil)
+	_ jsonv2.MarshalerTo     = Nullable{}
+	_ jsonv2.UnmarshalerFrom = (*Nullable)(nil)
+)
+
+func TestJSONv2Marshal(t *testing.T) {
+	s := struct {
+		String   Optional `json:"string"`
+		Empty    Optional `json:"empty"`
+		OmitZero Optional `json:"omitzero,omitzero"`
+		Unset    Nullable `json:"unset,omitzero"`
+		Null     Nullable `json:"null"`
+		Set      Nullable `json:"set"`
+	}{
+		String:   Of("s"),
+		Empty:    Empty(),
+		OmitZero: Empty(),
+		Null:     SetNullable(Empty()),
+		Set:      SetNullable(Of("")),
+	}
+
+	data, err := jsonv2.Marshal(s)
+	want := `{"string":"s","empty":null,"null":null,"set":""}`
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal got %s, %v, want %s", data, err, want)
+	}
+}
+
+func TestJSONv2Unmarshal(t *testing.T) {
+	var s struct {
+		String Optional `json:"string"`
+		Empty  Optional `json:"empty"`
+		Unset  Nullable `json:"unset"`
+		Null   Nullable `json:"null"`
+		Set    Nullable `json:"set"`
+	}
+	s.Empty = Of("s")
+
+	err := jsonv2.Unmarshal([]byte(`{"string":"s","empty":null,"null":null,"set":""}`), &s)
+	if err != nil {
+		t.Fatalf("Unmarshal got %v, want nil", err)
+	}
+	if s.String != Of("s") || s.Empty != Empty() {
+		t.Errorf("Unmarshal optionals got %#v, %#v, want s, empty", s.String, s.Empty)
+	}
+	if s.Unset.IsSet() || !s.Null.IsNull() || s.Set != SetNullable(Of("")) {
+		t.Errorf("Unmarshal nullables got %#v, %#v, %#v, want unset, null, set", s.Unset, s.Null, s.Set)
+	}
+
+	err = jsonv2.Unmarshal([]byte(`{"string":1}`), &s)
+	if err == nil {
+		t.Errorf("Unmarshal number into Optional got nil error, want error")
+	}
+}
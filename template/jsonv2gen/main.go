@@ -0,0 +1,149 @@
+// Command jsonv2gen generates the encoding/json/v2 MarshalerTo and
+// UnmarshalerFrom methods of an optional type generated from the template.
+// They are generated into a separate file because they are only available
+// when building with GOEXPERIMENT=jsonv2. Run it with go generate after
+// gotemplate, with the name of the optional type, and the name of its nullable
+// type if it is not the name of the optional type prefixed with Nullable:
+//
+//	//go:generate gotemplate "4d63.com/optional/template" OptionalMyType(MyType)
+//	//go:generate go run 4d63.com/optional/template/jsonv2gen OptionalMyType
+//
+// The -o flag sets the output file, which defaults to
+// gotemplate_<Optional>_jsonv2.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("jsonv2gen: ")
+	out := flag.String("o", "", "output file, defaults to gotemplate_<Optional>_jsonv2.go")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: jsonv2gen [-o file] Optional [Nullable]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 && flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	name := flag.Arg(0)
+	nullable := "Nullable" + name
+	if flag.NArg() == 2 {
+		nullable = flag.Arg(1)
+	}
+	if *out == "" {
+		*out = "gotemplate_" + name + "_jsonv2.go"
+	}
+	pkg := os.Getenv("GOPACKAGE")
+	if pkg == "" {
+		log.Fatal("GOPACKAGE is not set, run jsonv2gen with go generate")
+	}
+
+	src, err := generate(pkg, name, nullable)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(*out, src, 0o644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the methods for the optional type
+// name and the nullable type nullable in the package pkg.
+func generate(pkg, name, nullable string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `// Code generated by jsonv2gen. DO NOT EDIT.
+
+//go:build goexperiment.jsonv2
+
+package %[1]s
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"errors"
+	"math"
+	"reflect"
+)
+
+// MarshalJSONTo marshals the value being wrapped to the JSON encoder. If there
+// is no value being wrapped, null is marshaled. NaN and infinite floats, and
+// complex numbers, return errors.ErrUnsupported so that MarshalJSON is used.
+func (o %[2]s) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !o.present {
+		return enc.WriteToken(jsontext.Null)
+	}
+	switch v := reflect.ValueOf(o.value); v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return errors.ErrUnsupported
+		}
+	case reflect.Complex64, reflect.Complex128:
+		return errors.ErrUnsupported
+	}
+	return jsonv2.MarshalEncode(enc, o.value)
+}
+
+// UnmarshalJSONFrom unmarshals the next JSON value from the decoder into a
+// value wrapped by this optional. A JSON null unmarshals into an empty
+// optional. Complex numbers, and JSON strings for types other than strings,
+// return errors.ErrUnsupported so that UnmarshalJSON is used.
+func (o *%[2]s) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	k := reflect.TypeOf(&o.value).Elem().Kind()
+	if k == reflect.Complex64 || k == reflect.Complex128 {
+		return errors.ErrUnsupported
+	}
+	switch dec.PeekKind() {
+	case 'n':
+		_, err := dec.ReadToken()
+		if err != nil {
+			return err
+		}
+		*o = %[2]s{}
+		return nil
+	case '"':
+		if k != reflect.String {
+			return errors.ErrUnsupported
+		}
+	}
+	var v %[2]s
+	err := jsonv2.UnmarshalDecode(dec, &v.value)
+	if err != nil {
+		return err
+	}
+	v.present = true
+	*o = v
+	return nil
+}
+
+// MarshalJSONTo marshals the value being wrapped to the JSON encoder. If the
+// nullable is null or not set, null is marshaled.
+func (n %[3]s) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return n.value.MarshalJSONTo(enc)
+}
+
+// UnmarshalJSONFrom unmarshals the next JSON value from the decoder into a
+// value wrapped by this nullable, setting it. A JSON null sets the nullable to
+// null.
+func (n *%[3]s) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var o %[2]s
+	err := o.UnmarshalJSONFrom(dec)
+	if err != nil {
+		return err
+	}
+	*n = %[3]s{value: o, set: true}
+	return nil
+}
+`, pkg, name, nullable)
+
+	return format.Source(buf.Bytes())
+}
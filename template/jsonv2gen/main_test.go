@@ -0,0 +1,25 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestGenerate checks that the methods generated for the template match the
+// ones checked in as ../optional_jsonv2_test.go, which the template's tests
+// use, so that changes to the generator are tested and the fixture is kept up
+// to date with go generate.
+func TestGenerate(t *testing.T) {
+	want, err := os.ReadFile("../optional_jsonv2_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate("template", "Optional", "Nullable")
+	if err != nil {
+		t.Fatalf("generate got %v, want nil", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generate got:\n%s\nwant:\n%s\nrun go generate in the template directory", got, want)
+	}
+}
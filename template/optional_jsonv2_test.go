@@ -0,0 +1,82 @@
+// Code generated by jsonv2gen. DO NOT EDIT.
+
+//go:build goexperiment.jsonv2
+
+package template
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"errors"
+	"math"
+	"reflect"
+)
+
+// MarshalJSONTo marshals the value being wrapped to the JSON encoder. If there
+// is no value being wrapped, null is marshaled. NaN and infinite floats, and
+// complex numbers, return errors.ErrUnsupported so that MarshalJSON is used.
+func (o Optional) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !o.present {
+		return enc.WriteToken(jsontext.Null)
+	}
+	switch v := reflect.ValueOf(o.value); v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return errors.ErrUnsupported
+		}
+	case reflect.Complex64, reflect.Complex128:
+		return errors.ErrUnsupported
+	}
+	return jsonv2.MarshalEncode(enc, o.value)
+}
+
+// UnmarshalJSONFrom unmarshals the next JSON value from the decoder into a
+// value wrapped by this optional. A JSON null unmarshals into an empty
+// optional. Complex numbers, and JSON strings for types other than strings,
+// return errors.ErrUnsupported so that UnmarshalJSON is used.
+func (o *Optional) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	k := reflect.TypeOf(&o.value).Elem().Kind()
+	if k == reflect.Complex64 || k == reflect.Complex128 {
+		return errors.ErrUnsupported
+	}
+	switch dec.PeekKind() {
+	case 'n':
+		_, err := dec.ReadToken()
+		if err != nil {
+			return err
+		}
+		*o = Optional{}
+		return nil
+	case '"':
+		if k != reflect.String {
+			return errors.ErrUnsupported
+		}
+	}
+	var v Optional
+	err := jsonv2.UnmarshalDecode(dec, &v.value)
+	if err != nil {
+		return err
+	}
+	v.present = true
+	*o = v
+	return nil
+}
+
+// MarshalJSONTo marshals the value being wrapped to the JSON encoder. If the
+// nullable is null or not set, null is marshaled.
+func (n Nullable) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return n.value.MarshalJSONTo(enc)
+}
+
+// UnmarshalJSONFrom unmarshals the next JSON value from the decoder into a
+// value wrapped by this nullable, setting it. A JSON null sets the nullable to
+// null.
+func (n *Nullable) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var o Optional
+	err := o.UnmarshalJSONFrom(dec)
+	if err != nil {
+		return err
+	}
+	*n = Nullable{value: o, set: true}
+	return nil
+}
@@ -1,6 +1,20 @@
 package template
 
-import "testing"
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	optionalpkg "4d63.com/optional"
+)
+
+//go:generate go run ./jsonv2gen -o optional_jsonv2_test.go Optional Nullable
 
 func TestIsPresent(t *testing.T) {
 	s := "ptr to string"
@@ -143,3 +157,663 @@ func TestElseZero(t *testing.T) {
 		}
 	}
 }
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		Optional1     Optional
+		Optional2     Optional
+		ExpectedEqual bool
+	}{
+		{Empty(), Empty(), true},
+		{Empty(), Optional{}, true},
+		{Empty(), Of(""), false},
+		{Of(""), Of(""), true},
+		{Of("string"), Of("string"), true},
+		{Of("string"), Of("other"), false},
+		{OfOptionalPtr((*T)(nil)), Empty(), true},
+	}
+
+	for _, test := range tests {
+		equal := test.Optional1 == test.Optional2
+
+		if equal != test.ExpectedEqual {
+			t.Errorf("%#v == %#v got %#v, want %#v", test.Optional1, test.Optional2, equal, test.ExpectedEqual)
+		}
+	}
+}
+
+func TestPtr(t *testing.T) {
+	if p := Empty().Ptr(); p != nil {
+		t.Errorf("Empty().Ptr() got %v, want nil", p)
+	}
+	o := Of("string")
+	p := o.Ptr()
+	if p == nil || *p != "string" {
+		t.Fatalf("Ptr got %v, want pointer to %q", p, "string")
+	}
+	*p = "changed"
+	if o != Of("string") {
+		t.Errorf("Ptr returned pointer to the optional's value, want a copy")
+	}
+
+	var s Optional
+	s.SetPtr(p)
+	if s != Of("changed") {
+		t.Errorf("SetPtr got %#v, want %#v", s, Of("changed"))
+	}
+	s.SetPtr(nil)
+	if s != Empty() {
+		t.Errorf("SetPtr(nil) got %#v, want %#v", s, Empty())
+	}
+}
+
+func TestGetOrError(t *testing.T) {
+	errMissing := errors.New("missing")
+	tests := []struct {
+		Optional      Optional
+		Err           error
+		ExpectedValue T
+		ExpectedErr   error
+	}{
+		{Empty(), errMissing, "", errMissing},
+		{Empty(), nil, "", optionalpkg.ErrEmpty},
+		{Of(""), errMissing, "", nil},
+		{Of("string"), nil, "string", nil},
+	}
+
+	for _, test := range tests {
+		v, err := test.Optional.GetOrError(test.Err)
+		if v != test.ExpectedValue || !errors.Is(err, test.ExpectedErr) || (err == nil) != (test.ExpectedErr == nil) {
+			t.Errorf("%#v GetOrError(%v) got %#v, %v, want %#v, %v", test.Optional, test.Err, v, err, test.ExpectedValue, test.ExpectedErr)
+		}
+
+		called := false
+		v, err = test.Optional.ElseErr(func() error {
+			called = true
+			return test.Err
+		})
+		if v != test.ExpectedValue || !errors.Is(err, test.ExpectedErr) || (err == nil) != (test.ExpectedErr == nil) || called == test.Optional.IsPresent() {
+			t.Errorf("%#v ElseErr got %#v, %v, called %v, want %#v, %v", test.Optional, v, err, called, test.ExpectedValue, test.ExpectedErr)
+		}
+	}
+}
+
+func TestMustGet(t *testing.T) {
+	if v := Of("string").MustGet(); v != "string" {
+		t.Errorf("MustGet got %#v, want %#v", v, "string")
+	}
+	if v := Of("string").Expect("required"); v != "string" {
+		t.Errorf("Expect got %#v, want %#v", v, "string")
+	}
+
+	tests := []struct {
+		Func            func() T
+		ExpectedMessage string
+	}{
+		{func() T { return Empty().MustGet() }, "optional: no value wrapped by template.Optional"},
+		{func() T { return Empty().Expect("required") }, "required: optional: no value wrapped by template.Optional"},
+	}
+
+	for _, test := range tests {
+		err := recoverError(test.Func)
+		if !errors.Is(err, optionalpkg.ErrEmpty) || err.Error() != test.ExpectedMessage {
+			t.Errorf("panic got %v, want %q", err, test.ExpectedMessage)
+		}
+	}
+}
+
+func recoverError(f func() T) (err error) {
+	defer func() {
+		err, _ = recover().(error)
+	}()
+	f()
+	return nil
+}
+
+func TestOr(t *testing.T) {
+	tests := []struct {
+		Optional         Optional
+		Other            Optional
+		ExpectedOptional Optional
+	}{
+		{Empty(), Empty(), Empty()},
+		{Empty(), Of("other"), Of("other")},
+		{Of(""), Of("other"), Of("")},
+		{Of("string"), Empty(), Of("string")},
+	}
+
+	for _, test := range tests {
+		if o := test.Optional.Or(test.Other); o != test.ExpectedOptional {
+			t.Errorf("%#v Or(%#v) got %#v, want %#v", test.Optional, test.Other, o, test.ExpectedOptional)
+		}
+		called := false
+		o := test.Optional.OrFunc(func() Optional {
+			called = true
+			return test.Other
+		})
+		if o != test.ExpectedOptional || called == test.Optional.IsPresent() {
+			t.Errorf("%#v OrFunc got %#v, called %v, want %#v", test.Optional, o, called, test.ExpectedOptional)
+		}
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	upper := func(v T) T { return T(strings.ToUpper(string(v))) }
+	nonEmpty := func(v T) bool { return v != "" }
+	trim := func(v T) Optional {
+		if s := strings.TrimSpace(string(v)); s != "" {
+			return Of(T(s))
+		}
+		return Empty()
+	}
+	tests := []struct {
+		Optional        Optional
+		ExpectedMap     Optional
+		ExpectedFlatMap Optional
+		ExpectedFilter  Optional
+	}{
+		{Empty(), Empty(), Empty(), Empty()},
+		{Of(""), Of(""), Empty(), Empty()},
+		{Of(" "), Of(" "), Empty(), Of(" ")},
+		{Of("string "), Of("STRING "), Of("string"), Of("string ")},
+	}
+
+	for _, test := range tests {
+		if o := test.Optional.Map(upper); o != test.ExpectedMap {
+			t.Errorf("%#v Map got %#v, want %#v", test.Optional, o, test.ExpectedMap)
+		}
+		if o := test.Optional.FlatMap(trim); o != test.ExpectedFlatMap {
+			t.Errorf("%#v FlatMap got %#v, want %#v", test.Optional, o, test.ExpectedFlatMap)
+		}
+		if o := test.Optional.Filter(nonEmpty); o != test.ExpectedFilter {
+			t.Errorf("%#v Filter got %#v, want %#v", test.Optional, o, test.ExpectedFilter)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		Data             string
+		ExpectedOptional Optional
+	}{
+		{`null`, Empty()},
+		{`""`, Of("")},
+		{`"string"`, Of("string")},
+	}
+
+	for _, test := range tests {
+		o := Of("previous")
+		err := o.UnmarshalJSON([]byte(test.Data))
+
+		if err != nil || o != test.ExpectedOptional {
+			t.Errorf("%s UnmarshalJSON got %#v, %v, want %#v", test.Data, o, err, test.ExpectedOptional)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		Optional     Optional
+		ExpectedData string
+	}{
+		{Empty(), `null`},
+		{Of(""), `""`},
+		{Of("string"), `"string"`},
+	}
+
+	for _, test := range tests {
+		data, err := test.Optional.MarshalJSON()
+
+		if err != nil || string(data) != test.ExpectedData {
+			t.Errorf("%#v MarshalJSON got %s, %v, want %s", test.Optional, data, err, test.ExpectedData)
+		}
+
+		var o Optional
+		err = o.UnmarshalJSON(data)
+
+		if err != nil || o != test.Optional {
+			t.Errorf("%s UnmarshalJSON got %#v, %v, want %#v", data, o, err, test.Optional)
+		}
+	}
+}
+
+func TestNullable(t *testing.T) {
+	tests := []struct {
+		Nullable       Nullable
+		ExpectedIsSet  bool
+		ExpectedIsNull bool
+		ExpectedValue  T
+		ExpectedOk     bool
+	}{
+		{UnsetNullable(), false, false, "", false},
+		{Nullable{}, false, false, "", false},
+		{SetNullable(Empty()), true, true, "", false},
+		{SetNullable(Of("")), true, false, "", true},
+		{OfNullable("string"), true, false, "string", true},
+	}
+
+	for _, test := range tests {
+		isSet := test.Nullable.IsSet()
+		isNull := test.Nullable.IsNull()
+		value, ok := test.Nullable.Get()
+
+		if isSet != test.ExpectedIsSet || isNull != test.ExpectedIsNull {
+			t.Errorf("%#v IsSet, IsNull got %#v, %#v, want %#v, %#v", test.Nullable, isSet, isNull, test.ExpectedIsSet, test.ExpectedIsNull)
+		}
+		if value != test.ExpectedValue || ok != test.ExpectedOk {
+			t.Errorf("%#v Get got %#v, %#v, want %#v, %#v", test.Nullable, value, ok, test.ExpectedValue, test.ExpectedOk)
+		}
+	}
+}
+
+func TestNullableMarshal(t *testing.T) {
+	type s struct {
+		XMLName  xml.Name `json:"-" xml:"s"`
+		Nullable Nullable `json:"n,omitzero" xml:"n"`
+	}
+	tests := []struct {
+		Nullable     Nullable
+		ExpectedJSON string
+		ExpectedXML  string
+	}{
+		{UnsetNullable(), `{}`, `<s></s>`},
+		{SetNullable(Empty()), `{"n":null}`, `<s><n xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></n></s>`},
+		{OfNullable(""), `{"n":""}`, `<s><n></n></s>`},
+		{OfNullable("string"), `{"n":"string"}`, `<s><n>string</n></s>`},
+	}
+
+	for _, test := range tests {
+		j, err := json.Marshal(s{Nullable: test.Nullable})
+		if err != nil || string(j) != test.ExpectedJSON {
+			t.Errorf("%#v json.Marshal got %s, %v, want %s", test.Nullable, j, err, test.ExpectedJSON)
+		}
+		var fromJSON s
+		err = json.Unmarshal(j, &fromJSON)
+		if err != nil || fromJSON.Nullable != test.Nullable {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v", j, fromJSON.Nullable, err, test.Nullable)
+		}
+
+		x, err := xml.Marshal(s{Nullable: test.Nullable})
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Nullable, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.Nullable != test.Nullable {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.Nullable, err, test.Nullable)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		Src              interface{}
+		ExpectedOptional Optional
+	}{
+		{nil, Empty()},
+		{"", Of("")},
+		{"string", Of("string")},
+		{[]byte("bytes"), Of("bytes")},
+	}
+
+	for _, test := range tests {
+		o := Of("previous")
+		err := o.Scan(test.Src)
+
+		if err != nil || o != test.ExpectedOptional {
+			t.Errorf("%#v Scan got %#v, %v, want %#v", test.Src, o, err, test.ExpectedOptional)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		Optional      Optional
+		ExpectedValue interface{}
+	}{
+		{Empty(), nil},
+		{Of(""), ""},
+		{Of("string"), "string"},
+	}
+
+	for _, test := range tests {
+		value, err := test.Optional.Value()
+
+		if err != nil || value != test.ExpectedValue {
+			t.Errorf("%#v Value got %#v, %v, want %#v", test.Optional, value, err, test.ExpectedValue)
+		}
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	tests := []struct {
+		Optional     Optional
+		ExpectedText string
+	}{
+		{Empty(), ``},
+		{Of(""), ``},
+		{Of("string"), `string`},
+	}
+
+	for _, test := range tests {
+		text, err := test.Optional.MarshalText()
+
+		if err != nil || string(text) != test.ExpectedText {
+			t.Errorf("%#v MarshalText got %s, %v, want %s", test.Optional, text, err, test.ExpectedText)
+		}
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	tests := []struct {
+		Text             string
+		ExpectedOptional Optional
+	}{
+		{``, Empty()},
+		{`string`, Of("string")},
+	}
+
+	for _, test := range tests {
+		o := Of("previous")
+		err := o.UnmarshalText([]byte(test.Text))
+
+		if err != nil || o != test.ExpectedOptional {
+			t.Errorf("%s UnmarshalText got %#v, %v, want %#v", test.Text, o, err, test.ExpectedOptional)
+		}
+	}
+}
+
+func TestXMLAttr(t *testing.T) {
+	type s struct {
+		XMLName  xml.Name `xml:"s"`
+		Optional Optional `xml:"o,attr"`
+	}
+	tests := []struct {
+		Optional    Optional
+		ExpectedXML string
+	}{
+		{Empty(), `<s></s>`},
+		{Of(""), `<s o=""></s>`},
+		{Of("string"), `<s o="string"></s>`},
+	}
+
+	for _, test := range tests {
+		x, err := xml.Marshal(s{Optional: test.Optional})
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Optional, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.Optional != test.Optional {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.Optional, err, test.Optional)
+		}
+	}
+}
+
+func TestNullableXMLAttr(t *testing.T) {
+	type s struct {
+		XMLName  xml.Name `xml:"s"`
+		Nullable Nullable `xml:"n,attr"`
+	}
+	tests := []struct {
+		Nullable    Nullable
+		ExpectedXML string
+		Unmarshaled Nullable
+	}{
+		{UnsetNullable(), `<s></s>`, UnsetNullable()},
+		{SetNullable(Empty()), `<s></s>`, UnsetNullable()},
+		{OfNullable(""), `<s n=""></s>`, OfNullable("")},
+		{OfNullable("string"), `<s n="string"></s>`, OfNullable("string")},
+	}
+
+	for _, test := range tests {
+		x, err := xml.Marshal(s{Nullable: test.Nullable})
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Nullable, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.Nullable != test.Unmarshaled {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.Nullable, err, test.Unmarshaled)
+		}
+	}
+}
+
+func TestXMLNil(t *testing.T) {
+	type s struct {
+		XMLName xml.Name `xml:"s"`
+		XMLNil  XMLNil   `xml:"o"`
+	}
+	tests := []struct {
+		Optional    Optional
+		ExpectedXML string
+	}{
+		{Empty(), `<s><o xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></o></s>`},
+		{Of(""), `<s><o></o></s>`},
+		{Of("string"), `<s><o>string</o></s>`},
+	}
+
+	for _, test := range tests {
+		x, err := xml.Marshal(s{XMLNil: XMLNil{test.Optional}})
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Optional, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.XMLNil.Optional != test.Optional {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.XMLNil.Optional, err, test.Optional)
+		}
+	}
+}
+
+func TestNullableXMLNil(t *testing.T) {
+	type s struct {
+		XMLName  xml.Name `xml:"s"`
+		Nullable Nullable `xml:"n"`
+	}
+	tests := []struct {
+		Nullable    Nullable
+		ExpectedXML string
+	}{
+		{UnsetNullable(), `<s></s>`},
+		{SetNullable(Empty()), `<s><n xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></n></s>`},
+		{OfNullable("string"), `<s><n>string</n></s>`},
+	}
+
+	for _, test := range tests {
+		x, err := xml.Marshal(s{Nullable: test.Nullable})
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Nullable, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || fromXML.Nullable != test.Nullable {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML.Nullable, err, test.Nullable)
+		}
+	}
+}
+
+func TestUnmarshalXMLNil(t *testing.T) {
+	tests := []string{
+		`<s><o xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"/></s>`,
+		`<s xmlns:i="http://www.w3.org/2001/XMLSchema-instance"><o i:nil="true"/></s>`,
+		`<s><o xsi:nil="1"></o></s>`,
+	}
+
+	for _, x := range tests {
+		s := struct {
+			Optional Optional `xml:"o"`
+		}{Of("previous")}
+		err := xml.Unmarshal([]byte(x), &s)
+		if err != nil || s.Optional != Empty() {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, s.Optional, err, Empty())
+		}
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	tests := []struct {
+		Marshaler     interface{ MarshalYAML() (interface{}, error) }
+		ExpectedValue interface{}
+	}{
+		{Empty(), nil},
+		{Of(""), T("")},
+		{Of("string"), T("string")},
+		{UnsetNullable(), nil},
+		{SetNullable(Empty()), nil},
+		{OfNullable("string"), T("string")},
+	}
+
+	for _, test := range tests {
+		v, err := test.Marshaler.MarshalYAML()
+
+		if err != nil || v != test.ExpectedValue {
+			t.Errorf("%#v MarshalYAML got %#v, %v, want %#v", test.Marshaler, v, err, test.ExpectedValue)
+		}
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		Value            interface{}
+		ExpectedOptional Optional
+		ExpectedNullable Nullable
+	}{
+		{nil, Empty(), SetNullable(Empty())},
+		{"", Of(""), OfNullable("")},
+		{"string", Of("string"), OfNullable("string")},
+	}
+
+	for _, test := range tests {
+		o := Of("previous")
+		err := o.UnmarshalYAML(yamlUnmarshal(test.Value))
+		if err != nil || o != test.ExpectedOptional {
+			t.Errorf("%#v UnmarshalYAML got %#v, %v, want %#v", test.Value, o, err, test.ExpectedOptional)
+		}
+
+		var n Nullable
+		err = n.UnmarshalYAML(yamlUnmarshal(test.Value))
+		if err != nil || n != test.ExpectedNullable {
+			t.Errorf("%#v Nullable UnmarshalYAML got %#v, %v, want %#v", test.Value, n, err, test.ExpectedNullable)
+		}
+	}
+}
+
+// yamlUnmarshal returns an unmarshal function like the one that YAML libraries
+// pass to UnmarshalYAML, which unmarshals the value, leaving pointers nil if
+// the value is nil, the same as a YAML null.
+func yamlUnmarshal(value interface{}) func(interface{}) error {
+	return func(out interface{}) error {
+		if value == nil {
+			return nil
+		}
+		rv := reflect.ValueOf(out).Elem()
+		for rv.Kind() == reflect.Ptr {
+			rv.Set(reflect.New(rv.Type().Elem()))
+			rv = rv.Elem()
+		}
+		v := reflect.ValueOf(value)
+		if !v.Type().ConvertibleTo(rv.Type()) {
+			return fmt.Errorf("cannot unmarshal %T into %s", value, rv.Type())
+		}
+		rv.Set(v.Convert(rv.Type()))
+		return nil
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	tests := []struct {
+		Optional       Optional
+		ExpectedBinary []byte
+	}{
+		{Empty(), []byte{0}},
+		{Of(""), []byte{1}},
+		{Of("string"), []byte("\x01string")},
+	}
+
+	for _, test := range tests {
+		data, err := test.Optional.MarshalBinary()
+		if err != nil || !bytes.Equal(data, test.ExpectedBinary) {
+			t.Errorf("%#v MarshalBinary got %x, %v, want %x", test.Optional, data, err, test.ExpectedBinary)
+		}
+
+		data, err = test.Optional.AppendBinary([]byte("prefix"))
+		if err != nil || !bytes.Equal(data, append([]byte("prefix"), test.ExpectedBinary...)) {
+			t.Errorf("%#v AppendBinary got %x, %v, want prefix%x", test.Optional, data, err, test.ExpectedBinary)
+		}
+
+		o := Of("previous")
+		err = o.UnmarshalBinary(test.ExpectedBinary)
+		if err != nil || o != test.Optional {
+			t.Errorf("%x UnmarshalBinary got %#v, %v, want %#v", test.ExpectedBinary, o, err, test.Optional)
+		}
+	}
+}
+
+func TestUnmarshalBinaryError(t *testing.T) {
+	tests := [][]byte{
+		nil,
+		{0, 's'},
+		{2, 's'},
+	}
+
+	for _, data := range tests {
+		var o Optional
+		err := o.UnmarshalBinary(data)
+
+		if err == nil {
+			t.Errorf("%x UnmarshalBinary got %#v, nil error, want error", data, o)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type s struct {
+		Optional Optional
+	}
+	tests := []Optional{
+		Empty(),
+		Of(""),
+		Of("string"),
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(s{Optional: test})
+		if err != nil {
+			t.Errorf("%#v gob Encode got %v", test, err)
+			continue
+		}
+		var decoded s
+		err = gob.NewDecoder(&buf).Decode(&decoded)
+		if err != nil || decoded.Optional != test {
+			t.Errorf("%#v gob Decode got %#v, %v, want %#v", test, decoded.Optional, err, test)
+		}
+	}
+}
+
+func TestNullableGob(t *testing.T) {
+	type s struct {
+		Nullable Nullable
+	}
+	tests := []Nullable{
+		UnsetNullable(),
+		SetNullable(Empty()),
+		OfNullable(""),
+		OfNullable("string"),
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(s{Nullable: test})
+		if err != nil {
+			t.Errorf("%#v gob Encode got %v", test, err)
+			continue
+		}
+		var decoded s
+		err = gob.NewDecoder(&buf).Decode(&decoded)
+		if err != nil || decoded.Nullable != test {
+			t.Errorf("%#v gob Decode got %#v, %v, want %#v", test, decoded.Nullable, err, test)
+		}
+	}
+}
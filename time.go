@@ -0,0 +1,400 @@
+package optional
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The types in this file wrap a Time and marshal it in forms other than the
+// RFC 3339 form that time.Time uses, such as Unix timestamps or custom
+// layouts.
+
+// UnixTime wraps a Time and marshals it as the number of seconds since the
+// Unix epoch. Unmarshaled times are in UTC.
+type UnixTime struct {
+	Time
+}
+
+// AppendText appends the number of seconds since the Unix epoch of the value
+// being wrapped to b. If there is no value being wrapped, nothing is
+// appended.
+func (o UnixTime) AppendText(b []byte) ([]byte, error) {
+	t, ok := o.Get()
+	if !ok {
+		return b, nil
+	}
+	return strconv.AppendInt(b, t.Unix(), 10), nil
+}
+
+// MarshalText marshals the value being wrapped to the number of seconds since
+// the Unix epoch. If there is no value being wrapped, empty text is
+// marshaled.
+func (o UnixTime) MarshalText() ([]byte, error) {
+	return o.AppendText(nil)
+}
+
+// UnmarshalText unmarshals the number of seconds since the Unix epoch into a
+// value wrapped by this optional. Empty text unmarshals into an empty
+// optional.
+func (o *UnixTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		o.Time = EmptyTime()
+		return nil
+	}
+	sec, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	o.Time = OfTime(time.Unix(sec, 0).UTC())
+	return nil
+}
+
+// MarshalJSON marshals the value being wrapped to a JSON number. If there is
+// no value being wrapped, null is marshaled.
+func (o UnixTime) MarshalJSON() ([]byte, error) {
+	return marshalJSONText(o, false)
+}
+
+// UnmarshalJSON unmarshals the JSON number, or string, into a value wrapped
+// by this optional. A JSON null unmarshals into an empty optional.
+func (o *UnixTime) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(o, data)
+}
+
+// MarshalYAML marshals the value being wrapped to a YAML number. If there is
+// no value being wrapped, null is marshaled.
+func (o UnixTime) MarshalYAML() (interface{}, error) {
+	t, ok := o.Get()
+	if !ok {
+		return nil, nil
+	}
+	return t.Unix(), nil
+}
+
+// UnmarshalYAML unmarshals the YAML number into a value wrapped by this
+// optional. A YAML null unmarshals into an empty optional.
+func (o *UnixTime) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, o)
+}
+
+// MarshalXML marshals the value being wrapped to XML the same as MarshalText.
+func (o UnixTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLText(e, start, o, o.Time)
+}
+
+// UnmarshalXML unmarshals the XML into a value wrapped by this optional the
+// same as UnmarshalText.
+func (o *UnixTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, o)
+}
+
+// MarshalXMLAttr marshals the value being wrapped to an XML attribute the
+// same as MarshalText. If there is no value being wrapped, no attribute is
+// marshaled.
+func (o UnixTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttrText(name, o)
+}
+
+// UnmarshalXMLAttr unmarshals the XML attribute into a value wrapped by this
+// optional the same as UnmarshalText.
+func (o *UnixTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return o.UnmarshalText([]byte(attr.Value))
+}
+
+// UnixMilliTime wraps a Time and marshals it as the number of milliseconds
+// since the Unix epoch. Unmarshaled times are in UTC.
+type UnixMilliTime struct {
+	Time
+}
+
+// AppendText appends the number of milliseconds since the Unix epoch of the
+// value being wrapped to b. If there is no value being wrapped, nothing is
+// appended.
+func (o UnixMilliTime) AppendText(b []byte) ([]byte, error) {
+	t, ok := o.Get()
+	if !ok {
+		return b, nil
+	}
+	return strconv.AppendInt(b, t.UnixMilli(), 10), nil
+}
+
+// MarshalText marshals the value being wrapped to the number of milliseconds
+// since the Unix epoch. If there is no value being wrapped, empty text is
+// marshaled.
+func (o UnixMilliTime) MarshalText() ([]byte, error) {
+	return o.AppendText(nil)
+}
+
+// UnmarshalText unmarshals the number of milliseconds since the Unix epoch
+// into a value wrapped by this optional. Empty text unmarshals into an empty
+// optional.
+func (o *UnixMilliTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		o.Time = EmptyTime()
+		return nil
+	}
+	msec, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	o.Time = OfTime(time.UnixMilli(msec).UTC())
+	return nil
+}
+
+// MarshalJSON marshals the value being wrapped to a JSON number. If there is
+// no value being wrapped, null is marshaled.
+func (o UnixMilliTime) MarshalJSON() ([]byte, error) {
+	return marshalJSONText(o, false)
+}
+
+// UnmarshalJSON unmarshals the JSON number, or string, into a value wrapped
+// by this optional. A JSON null unmarshals into an empty optional.
+func (o *UnixMilliTime) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(o, data)
+}
+
+// MarshalYAML marshals the value being wrapped to a YAML number. If there is
+// no value being wrapped, null is marshaled.
+func (o UnixMilliTime) MarshalYAML() (interface{}, error) {
+	t, ok := o.Get()
+	if !ok {
+		return nil, nil
+	}
+	return t.UnixMilli(), nil
+}
+
+// UnmarshalYAML unmarshals the YAML number into a value wrapped by this
+// optional. A YAML null unmarshals into an empty optional.
+func (o *UnixMilliTime) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, o)
+}
+
+// MarshalXML marshals the value being wrapped to XML the same as MarshalText.
+func (o UnixMilliTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLText(e, start, o, o.Time)
+}
+
+// UnmarshalXML unmarshals the XML into a value wrapped by this optional the
+// same as UnmarshalText.
+func (o *UnixMilliTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, o)
+}
+
+// MarshalXMLAttr marshals the value being wrapped to an XML attribute the
+// same as MarshalText. If there is no value being wrapped, no attribute is
+// marshaled.
+func (o UnixMilliTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttrText(name, o)
+}
+
+// UnmarshalXMLAttr unmarshals the XML attribute into a value wrapped by this
+// optional the same as UnmarshalText.
+func (o *UnixMilliTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return o.UnmarshalText([]byte(attr.Value))
+}
+
+// TimeLayout provides the layouts, in the form accepted by time.Parse, that a
+// LayoutTime is marshaled and unmarshaled with. The first layout is used to
+// marshal, and each layout is tried in order to unmarshal.
+type TimeLayout interface {
+	Layouts() []string
+}
+
+// DateLayout is a TimeLayout for dates, such as 2006-01-02.
+type DateLayout struct{}
+
+// Layouts returns the time.DateOnly layout.
+func (DateLayout) Layouts() []string {
+	return []string{time.DateOnly}
+}
+
+// RFC1123Layout is a TimeLayout for RFC 1123 times, such as
+// Mon, 02 Jan 2006 15:04:05 MST. Times with a numeric zone are also
+// unmarshaled.
+type RFC1123Layout struct{}
+
+// Layouts returns the time.RFC1123 and time.RFC1123Z layouts.
+func (RFC1123Layout) Layouts() []string {
+	return []string{time.RFC1123, time.RFC1123Z}
+}
+
+// LayoutTime wraps a Time and marshals it using the layouts of L.
+type LayoutTime[L TimeLayout] struct {
+	Time
+}
+
+// AppendText appends the value being wrapped, formatted using the first
+// layout, to b. If there is no value being wrapped, nothing is appended. An
+// error is returned if L has no layouts.
+func (o LayoutTime[L]) AppendText(b []byte) ([]byte, error) {
+	t, ok := o.Get()
+	if !ok {
+		return b, nil
+	}
+	var l L
+	layouts := l.Layouts()
+	if len(layouts) == 0 {
+		return b, fmt.Errorf("optional: cannot format time with no layouts in %T", l)
+	}
+	return t.AppendFormat(b, layouts[0]), nil
+}
+
+// MarshalText marshals the value being wrapped, formatted using the first
+// layout. If there is no value being wrapped, empty text is marshaled.
+func (o LayoutTime[L]) MarshalText() ([]byte, error) {
+	return o.AppendText(nil)
+}
+
+// UnmarshalText unmarshals the text, parsed using the first layout that
+// matches, into a value wrapped by this optional. Empty text unmarshals into
+// an empty optional.
+func (o *LayoutTime[L]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		o.Time = EmptyTime()
+		return nil
+	}
+	var l L
+	layouts := l.Layouts()
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, string(text))
+		if err == nil {
+			o.Time = OfTime(t)
+			return nil
+		}
+	}
+	return fmt.Errorf("optional: cannot parse %q as a time with layouts %q", text, layouts)
+}
+
+// MarshalJSON marshals the value being wrapped to a JSON string, formatted
+// using the first layout. If there is no value being wrapped, null is
+// marshaled.
+func (o LayoutTime[L]) MarshalJSON() ([]byte, error) {
+	return marshalJSONText(o, true)
+}
+
+// UnmarshalJSON unmarshals the JSON string, parsed using the first layout
+// that matches, into a value wrapped by this optional. A JSON null unmarshals
+// into an empty optional.
+func (o *LayoutTime[L]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(o, data)
+}
+
+// MarshalYAML marshals the value being wrapped to a YAML string, formatted
+// using the first layout. If there is no value being wrapped, null is
+// marshaled.
+func (o LayoutTime[L]) MarshalYAML() (interface{}, error) {
+	return marshalYAMLText(o)
+}
+
+// UnmarshalYAML unmarshals the YAML string, parsed using the first layout
+// that matches, into a value wrapped by this optional. A YAML null unmarshals
+// into an empty optional.
+func (o *LayoutTime[L]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, o)
+}
+
+// MarshalXML marshals the value being wrapped to XML the same as MarshalText.
+func (o LayoutTime[L]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLText(e, start, o, o.Time)
+}
+
+// UnmarshalXML unmarshals the XML into a value wrapped by this optional the
+// same as UnmarshalText.
+func (o *LayoutTime[L]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, o)
+}
+
+// MarshalXMLAttr marshals the value being wrapped to an XML attribute the
+// same as MarshalText. If there is no value being wrapped, no attribute is
+// marshaled.
+func (o LayoutTime[L]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttrText(name, o)
+}
+
+// UnmarshalXMLAttr unmarshals the XML attribute into a value wrapped by this
+// optional the same as UnmarshalText.
+func (o *LayoutTime[L]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return o.UnmarshalText([]byte(attr.Value))
+}
+
+func marshalJSONText(o textOptional, quoted bool) ([]byte, error) {
+	if !o.IsPresent() {
+		return []byte("null"), nil
+	}
+	if quoted {
+		text, err := o.AppendText(nil)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(text))
+	}
+	return o.AppendText(nil)
+}
+
+// marshalYAMLText marshals the optional as a YAML string of its text, or
+// null if it is empty.
+func marshalYAMLText(o textOptional) (interface{}, error) {
+	if !o.IsPresent() {
+		return nil, nil
+	}
+	text, err := o.AppendText(nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// unmarshalYAMLText unmarshals a YAML scalar into the optional using its
+// text. A YAML null unmarshals the same as empty text.
+func unmarshalYAMLText(unmarshal func(interface{}) error, o encoding.TextUnmarshaler) error {
+	var s *string
+	err := unmarshal(&s)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return o.UnmarshalText(nil)
+	}
+	return o.UnmarshalText([]byte(*s))
+}
+
+// marshalXMLText marshals the optional as an element containing its text. If
+// the optional is empty, it is marshaled by empty, which omits it or marshals
+// it with xsi:nil.
+func marshalXMLText(e *xml.Encoder, start xml.StartElement, o textOptional, empty xml.Marshaler) error {
+	if !o.IsPresent() {
+		return empty.MarshalXML(e, start)
+	}
+	text, err := o.AppendText(nil)
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(text), start)
+}
+
+// unmarshalXMLText unmarshals the text of the element into the optional. An
+// element with xsi:nil has no text and so unmarshals into an empty optional.
+func unmarshalXMLText(d *xml.Decoder, start xml.StartElement, o encoding.TextUnmarshaler) error {
+	var s string
+	err := d.DecodeElement(&s, &start)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalText([]byte(strings.TrimSpace(s)))
+}
+
+func marshalXMLAttrText(name xml.Name, o textOptional) (xml.Attr, error) {
+	if !o.IsPresent() {
+		return xml.Attr{}, nil
+	}
+	text, err := o.AppendText(nil)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
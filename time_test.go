@@ -0,0 +1,158 @@
+package optional
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestTimeLayouts(t *testing.T) {
+	type s struct {
+		XMLName xml.Name                  `json:"-" xml:"s"`
+		Unix    UnixTime                  `json:"unix" xml:"unix"`
+		Milli   UnixMilliTime             `json:"milli" xml:"milli,attr"`
+		Date    LayoutTime[DateLayout]    `json:"date" xml:"date"`
+		RFC1123 LayoutTime[RFC1123Layout] `json:"rfc1123" xml:"rfc1123"`
+	}
+	tm := time.Date(2006, 1, 2, 15, 4, 5, 999000000, time.UTC)
+	tests := []struct {
+		Value        s
+		Expected     s
+		ExpectedJSON string
+		ExpectedXML  string
+	}{
+		{
+			s{},
+			s{},
+			`{"unix":null,"milli":null,"date":null,"rfc1123":null}`,
+			`<s></s>`,
+		},
+		{
+			s{
+				Unix:    UnixTime{OfTime(tm)},
+				Milli:   UnixMilliTime{OfTime(tm)},
+				Date:    LayoutTime[DateLayout]{OfTime(tm)},
+				RFC1123: LayoutTime[RFC1123Layout]{OfTime(tm)},
+			},
+			s{
+				Unix:    UnixTime{OfTime(tm.Truncate(time.Second))},
+				Milli:   UnixMilliTime{OfTime(tm)},
+				Date:    LayoutTime[DateLayout]{OfTime(tm.Truncate(24 * time.Hour))},
+				RFC1123: LayoutTime[RFC1123Layout]{OfTime(tm.Truncate(time.Second))},
+			},
+			`{"unix":1136214245,"milli":1136214245999,"date":"2006-01-02","rfc1123":"Mon, 02 Jan 2006 15:04:05 UTC"}`,
+			`<s milli="1136214245999"><unix>1136214245</unix><date>2006-01-02</date><rfc1123>Mon, 02 Jan 2006 15:04:05 UTC</rfc1123></s>`,
+		},
+	}
+
+	for _, test := range tests {
+		j, err := json.Marshal(test.Value)
+		if err != nil || string(j) != test.ExpectedJSON {
+			t.Errorf("%#v json.Marshal got %s, %v, want %s", test.Value, j, err, test.ExpectedJSON)
+		}
+		var fromJSON s
+		err = json.Unmarshal(j, &fromJSON)
+		if err != nil || !timesEqual(fromJSON, test.Expected) {
+			t.Errorf("%s json.Unmarshal got %#v, %v, want %#v", j, fromJSON, err, test.Expected)
+		}
+
+		x, err := xml.Marshal(test.Value)
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Value, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		if err != nil || !timesEqual(fromXML, test.Expected) {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML, err, test.Expected)
+		}
+	}
+}
+
+func timesEqual[S any](s1, s2 S) bool {
+	j1, _ := json.Marshal(s1)
+	j2, _ := json.Marshal(s2)
+	return string(j1) == string(j2)
+}
+
+func TestTimeLayoutFallback(t *testing.T) {
+	tests := []struct {
+		Data         string
+		ExpectedTime time.Time
+		ExpectedErr  bool
+	}{
+		{`"Mon, 02 Jan 2006 15:04:05 UTC"`, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{`"Mon, 02 Jan 2006 15:04:05 +0000"`, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{`"2006-01-02T15:04:05Z"`, time.Time{}, true},
+	}
+
+	for _, test := range tests {
+		var o LayoutTime[RFC1123Layout]
+		err := json.Unmarshal([]byte(test.Data), &o)
+		tm, _ := o.Get()
+
+		if (err != nil) != test.ExpectedErr || !tm.Equal(test.ExpectedTime) {
+			t.Errorf("%s json.Unmarshal got %v, %v, want %v, error %v", test.Data, tm, err, test.ExpectedTime, test.ExpectedErr)
+		}
+	}
+}
+
+type noLayout struct{}
+
+func (noLayout) Layouts() []string { return nil }
+
+func TestTimeNoLayouts(t *testing.T) {
+	o := LayoutTime[noLayout]{OfTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))}
+	text, err := o.MarshalText()
+	if err == nil {
+		t.Errorf("MarshalText got %q, want error", text)
+	}
+	data, err := json.Marshal(o)
+	if err == nil {
+		t.Errorf("json.Marshal got %s, want error", data)
+	}
+
+	var fromText LayoutTime[noLayout]
+	err = fromText.UnmarshalText([]byte("2006-01-02"))
+	if err == nil {
+		t.Errorf("UnmarshalText got %v, want error", fromText)
+	}
+}
+
+func TestTimeYAML(t *testing.T) {
+	tm := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		Optional      interface{ MarshalYAML() (interface{}, error) }
+		ExpectedValue interface{}
+	}{
+		{UnixTime{OfTime(tm)}, tm.Unix()},
+		{UnixTime{EmptyTime()}, nil},
+		{UnixMilliTime{OfTime(tm)}, tm.UnixMilli()},
+		{LayoutTime[DateLayout]{OfTime(tm)}, "2006-01-02"},
+		{LayoutTime[DateLayout]{EmptyTime()}, nil},
+	}
+
+	for _, test := range tests {
+		v, err := test.Optional.MarshalYAML()
+
+		if err != nil || v != test.ExpectedValue {
+			t.Errorf("%#v MarshalYAML got %#v, %v, want %#v", test.Optional, v, err, test.ExpectedValue)
+		}
+	}
+
+	var u UnixTime
+	err := u.UnmarshalYAML(yamlUnmarshal("1136214245"))
+	if got, _ := u.Get(); err != nil || !got.Equal(tm) {
+		t.Errorf("UnixTime UnmarshalYAML got %v, %v, want %v", got, err, tm)
+	}
+	err = u.UnmarshalYAML(yamlUnmarshal(nil))
+	if err != nil || u.IsPresent() {
+		t.Errorf("UnixTime UnmarshalYAML null got %#v, %v, want empty", u, err)
+	}
+
+	var d LayoutTime[DateLayout]
+	err = d.UnmarshalYAML(yamlUnmarshal("2006-01-02"))
+	if got, _ := d.Get(); err != nil || !got.Equal(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("LayoutTime UnmarshalYAML got %v, %v, want 2006-01-02", got, err)
+	}
+}
@@ -1,23 +1,7 @@
 package optional
 
-//go:generate gotemplate "4d63.com/optional/template" Bool(bool)
-//go:generate gotemplate "4d63.com/optional/template" Byte(byte)
-//go:generate gotemplate "4d63.com/optional/template" Complex128(complex128)
-//go:generate gotemplate "4d63.com/optional/template" Complex64(complex64)
-//go:generate gotemplate "4d63.com/optional/template" Float32(float32)
-//go:generate gotemplate "4d63.com/optional/template" Float64(float64)
-//go:generate gotemplate "4d63.com/optional/template" Int(int)
-//go:generate gotemplate "4d63.com/optional/template" Int16(int16)
-//go:generate gotemplate "4d63.com/optional/template" Int32(int32)
-//go:generate gotemplate "4d63.com/optional/template" Int64(int64)
-//go:generate gotemplate "4d63.com/optional/template" Int8(int8)
-//go:generate gotemplate "4d63.com/optional/template" Rune(rune)
-//go:generate gotemplate "4d63.com/optional/template" String(string)
-//go:generate gotemplate "4d63.com/optional/template" Uint(uint)
-//go:generate gotemplate "4d63.com/optional/template" Uint16(uint16)
-//go:generate gotemplate "4d63.com/optional/template" Uint32(uint32)
-//go:generate gotemplate "4d63.com/optional/template" Uint64(uint64)
-//go:generate gotemplate "4d63.com/optional/template" Uint8(uint8)
-//go:generate gotemplate "4d63.com/optional/template" Uintptr(uintptr)
+// The named optional types, such as Int and Time, are aliases of Optional for
+// the builtin types, kept for compatibility. Add a type to types_gen.go to
+// add another.
 
-//go:generate gotemplate "4d63.com/optional/template" Time(time.Time)
+//go:generate go run types_gen.go
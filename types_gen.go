@@ -0,0 +1,108 @@
+//go:build ignore
+
+// This program generates types_generated.go, the named optional types of the
+// builtin types, which are aliases of Optional, and their constructors. Run
+// it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+// namedTypes are the names of the optional types and the types they wrap.
+var namedTypes = []struct {
+	Name string
+	Type string
+}{
+	{"Bool", "bool"},
+	{"Byte", "byte"},
+	{"Complex128", "complex128"},
+	{"Complex64", "complex64"},
+	{"Float32", "float32"},
+	{"Float64", "float64"},
+	{"Int", "int"},
+	{"Int16", "int16"},
+	{"Int32", "int32"},
+	{"Int64", "int64"},
+	{"Int8", "int8"},
+	{"Rune", "rune"},
+	{"String", "string"},
+	{"Uint", "uint"},
+	{"Uint16", "uint16"},
+	{"Uint32", "uint32"},
+	{"Uint64", "uint64"},
+	{"Uint8", "uint8"},
+	{"Uintptr", "uintptr"},
+	{"Time", "time.Time"},
+}
+
+func main() {
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, `// Code generated by types_gen.go. DO NOT EDIT.
+
+package optional
+
+import (
+	"database/sql"
+	"time"
+)
+`)
+	for _, t := range namedTypes {
+		fmt.Fprintf(&buf, `
+// %[1]s is an optional %[2]s.
+type %[1]s = Optional[%[2]s]
+
+// Nullable%[1]s is a nullable %[2]s.
+type Nullable%[1]s = Nullable[%[2]s]
+
+// Of%[1]s wraps the value in an optional.
+func Of%[1]s(value %[2]s) %[1]s {
+	return Of(value)
+}
+
+// Of%[1]sPtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func Of%[1]sPtr(ptr *%[2]s) %[1]s {
+	return OfPtr(ptr)
+}
+
+// Empty%[1]s returns an empty optional.
+func Empty%[1]s() %[1]s {
+	return Empty[%[2]s]()
+}
+
+// OfSQLNull%[1]s wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNull%[1]s(n sql.Null[%[2]s]) %[1]s {
+	return OfSQLNull(n)
+}
+
+// OfNullable%[1]s returns a nullable that is set to the value.
+func OfNullable%[1]s(value %[2]s) Nullable%[1]s {
+	return OfNullable(value)
+}
+
+// SetNullable%[1]s returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullable%[1]s(o %[1]s) Nullable%[1]s {
+	return SetNullable(o)
+}
+
+// UnsetNullable%[1]s returns a nullable that is not set.
+func UnsetNullable%[1]s() Nullable%[1]s {
+	return UnsetNullable[%[2]s]()
+}
+`, t.Name, t.Type)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("types_generated.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,888 @@
+// Code generated by types_gen.go. DO NOT EDIT.
+
+package optional
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Bool is an optional bool.
+type Bool = Optional[bool]
+
+// NullableBool is a nullable bool.
+type NullableBool = Nullable[bool]
+
+// OfBool wraps the value in an optional.
+func OfBool(value bool) Bool {
+	return Of(value)
+}
+
+// OfBoolPtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfBoolPtr(ptr *bool) Bool {
+	return OfPtr(ptr)
+}
+
+// EmptyBool returns an empty optional.
+func EmptyBool() Bool {
+	return Empty[bool]()
+}
+
+// OfSQLNullBool wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullBool(n sql.Null[bool]) Bool {
+	return OfSQLNull(n)
+}
+
+// OfNullableBool returns a nullable that is set to the value.
+func OfNullableBool(value bool) NullableBool {
+	return OfNullable(value)
+}
+
+// SetNullableBool returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableBool(o Bool) NullableBool {
+	return SetNullable(o)
+}
+
+// UnsetNullableBool returns a nullable that is not set.
+func UnsetNullableBool() NullableBool {
+	return UnsetNullable[bool]()
+}
+
+// Byte is an optional byte.
+type Byte = Optional[byte]
+
+// NullableByte is a nullable byte.
+type NullableByte = Nullable[byte]
+
+// OfByte wraps the value in an optional.
+func OfByte(value byte) Byte {
+	return Of(value)
+}
+
+// OfBytePtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfBytePtr(ptr *byte) Byte {
+	return OfPtr(ptr)
+}
+
+// EmptyByte returns an empty optional.
+func EmptyByte() Byte {
+	return Empty[byte]()
+}
+
+// OfSQLNullByte wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullByte(n sql.Null[byte]) Byte {
+	return OfSQLNull(n)
+}
+
+// OfNullableByte returns a nullable that is set to the value.
+func OfNullableByte(value byte) NullableByte {
+	return OfNullable(value)
+}
+
+// SetNullableByte returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableByte(o Byte) NullableByte {
+	return SetNullable(o)
+}
+
+// UnsetNullableByte returns a nullable that is not set.
+func UnsetNullableByte() NullableByte {
+	return UnsetNullable[byte]()
+}
+
+// Complex128 is an optional complex128.
+type Complex128 = Optional[complex128]
+
+// NullableComplex128 is a nullable complex128.
+type NullableComplex128 = Nullable[complex128]
+
+// OfComplex128 wraps the value in an optional.
+func OfComplex128(value complex128) Complex128 {
+	return Of(value)
+}
+
+// OfComplex128Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfComplex128Ptr(ptr *complex128) Complex128 {
+	return OfPtr(ptr)
+}
+
+// EmptyComplex128 returns an empty optional.
+func EmptyComplex128() Complex128 {
+	return Empty[complex128]()
+}
+
+// OfSQLNullComplex128 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullComplex128(n sql.Null[complex128]) Complex128 {
+	return OfSQLNull(n)
+}
+
+// OfNullableComplex128 returns a nullable that is set to the value.
+func OfNullableComplex128(value complex128) NullableComplex128 {
+	return OfNullable(value)
+}
+
+// SetNullableComplex128 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableComplex128(o Complex128) NullableComplex128 {
+	return SetNullable(o)
+}
+
+// UnsetNullableComplex128 returns a nullable that is not set.
+func UnsetNullableComplex128() NullableComplex128 {
+	return UnsetNullable[complex128]()
+}
+
+// Complex64 is an optional complex64.
+type Complex64 = Optional[complex64]
+
+// NullableComplex64 is a nullable complex64.
+type NullableComplex64 = Nullable[complex64]
+
+// OfComplex64 wraps the value in an optional.
+func OfComplex64(value complex64) Complex64 {
+	return Of(value)
+}
+
+// OfComplex64Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfComplex64Ptr(ptr *complex64) Complex64 {
+	return OfPtr(ptr)
+}
+
+// EmptyComplex64 returns an empty optional.
+func EmptyComplex64() Complex64 {
+	return Empty[complex64]()
+}
+
+// OfSQLNullComplex64 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullComplex64(n sql.Null[complex64]) Complex64 {
+	return OfSQLNull(n)
+}
+
+// OfNullableComplex64 returns a nullable that is set to the value.
+func OfNullableComplex64(value complex64) NullableComplex64 {
+	return OfNullable(value)
+}
+
+// SetNullableComplex64 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableComplex64(o Complex64) NullableComplex64 {
+	return SetNullable(o)
+}
+
+// UnsetNullableComplex64 returns a nullable that is not set.
+func UnsetNullableComplex64() NullableComplex64 {
+	return UnsetNullable[complex64]()
+}
+
+// Float32 is an optional float32.
+type Float32 = Optional[float32]
+
+// NullableFloat32 is a nullable float32.
+type NullableFloat32 = Nullable[float32]
+
+// OfFloat32 wraps the value in an optional.
+func OfFloat32(value float32) Float32 {
+	return Of(value)
+}
+
+// OfFloat32Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfFloat32Ptr(ptr *float32) Float32 {
+	return OfPtr(ptr)
+}
+
+// EmptyFloat32 returns an empty optional.
+func EmptyFloat32() Float32 {
+	return Empty[float32]()
+}
+
+// OfSQLNullFloat32 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullFloat32(n sql.Null[float32]) Float32 {
+	return OfSQLNull(n)
+}
+
+// OfNullableFloat32 returns a nullable that is set to the value.
+func OfNullableFloat32(value float32) NullableFloat32 {
+	return OfNullable(value)
+}
+
+// SetNullableFloat32 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableFloat32(o Float32) NullableFloat32 {
+	return SetNullable(o)
+}
+
+// UnsetNullableFloat32 returns a nullable that is not set.
+func UnsetNullableFloat32() NullableFloat32 {
+	return UnsetNullable[float32]()
+}
+
+// Float64 is an optional float64.
+type Float64 = Optional[float64]
+
+// NullableFloat64 is a nullable float64.
+type NullableFloat64 = Nullable[float64]
+
+// OfFloat64 wraps the value in an optional.
+func OfFloat64(value float64) Float64 {
+	return Of(value)
+}
+
+// OfFloat64Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfFloat64Ptr(ptr *float64) Float64 {
+	return OfPtr(ptr)
+}
+
+// EmptyFloat64 returns an empty optional.
+func EmptyFloat64() Float64 {
+	return Empty[float64]()
+}
+
+// OfSQLNullFloat64 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullFloat64(n sql.Null[float64]) Float64 {
+	return OfSQLNull(n)
+}
+
+// OfNullableFloat64 returns a nullable that is set to the value.
+func OfNullableFloat64(value float64) NullableFloat64 {
+	return OfNullable(value)
+}
+
+// SetNullableFloat64 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableFloat64(o Float64) NullableFloat64 {
+	return SetNullable(o)
+}
+
+// UnsetNullableFloat64 returns a nullable that is not set.
+func UnsetNullableFloat64() NullableFloat64 {
+	return UnsetNullable[float64]()
+}
+
+// Int is an optional int.
+type Int = Optional[int]
+
+// NullableInt is a nullable int.
+type NullableInt = Nullable[int]
+
+// OfInt wraps the value in an optional.
+func OfInt(value int) Int {
+	return Of(value)
+}
+
+// OfIntPtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfIntPtr(ptr *int) Int {
+	return OfPtr(ptr)
+}
+
+// EmptyInt returns an empty optional.
+func EmptyInt() Int {
+	return Empty[int]()
+}
+
+// OfSQLNullInt wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullInt(n sql.Null[int]) Int {
+	return OfSQLNull(n)
+}
+
+// OfNullableInt returns a nullable that is set to the value.
+func OfNullableInt(value int) NullableInt {
+	return OfNullable(value)
+}
+
+// SetNullableInt returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableInt(o Int) NullableInt {
+	return SetNullable(o)
+}
+
+// UnsetNullableInt returns a nullable that is not set.
+func UnsetNullableInt() NullableInt {
+	return UnsetNullable[int]()
+}
+
+// Int16 is an optional int16.
+type Int16 = Optional[int16]
+
+// NullableInt16 is a nullable int16.
+type NullableInt16 = Nullable[int16]
+
+// OfInt16 wraps the value in an optional.
+func OfInt16(value int16) Int16 {
+	return Of(value)
+}
+
+// OfInt16Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfInt16Ptr(ptr *int16) Int16 {
+	return OfPtr(ptr)
+}
+
+// EmptyInt16 returns an empty optional.
+func EmptyInt16() Int16 {
+	return Empty[int16]()
+}
+
+// OfSQLNullInt16 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullInt16(n sql.Null[int16]) Int16 {
+	return OfSQLNull(n)
+}
+
+// OfNullableInt16 returns a nullable that is set to the value.
+func OfNullableInt16(value int16) NullableInt16 {
+	return OfNullable(value)
+}
+
+// SetNullableInt16 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableInt16(o Int16) NullableInt16 {
+	return SetNullable(o)
+}
+
+// UnsetNullableInt16 returns a nullable that is not set.
+func UnsetNullableInt16() NullableInt16 {
+	return UnsetNullable[int16]()
+}
+
+// Int32 is an optional int32.
+type Int32 = Optional[int32]
+
+// NullableInt32 is a nullable int32.
+type NullableInt32 = Nullable[int32]
+
+// OfInt32 wraps the value in an optional.
+func OfInt32(value int32) Int32 {
+	return Of(value)
+}
+
+// OfInt32Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfInt32Ptr(ptr *int32) Int32 {
+	return OfPtr(ptr)
+}
+
+// EmptyInt32 returns an empty optional.
+func EmptyInt32() Int32 {
+	return Empty[int32]()
+}
+
+// OfSQLNullInt32 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullInt32(n sql.Null[int32]) Int32 {
+	return OfSQLNull(n)
+}
+
+// OfNullableInt32 returns a nullable that is set to the value.
+func OfNullableInt32(value int32) NullableInt32 {
+	return OfNullable(value)
+}
+
+// SetNullableInt32 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableInt32(o Int32) NullableInt32 {
+	return SetNullable(o)
+}
+
+// UnsetNullableInt32 returns a nullable that is not set.
+func UnsetNullableInt32() NullableInt32 {
+	return UnsetNullable[int32]()
+}
+
+// Int64 is an optional int64.
+type Int64 = Optional[int64]
+
+// NullableInt64 is a nullable int64.
+type NullableInt64 = Nullable[int64]
+
+// OfInt64 wraps the value in an optional.
+func OfInt64(value int64) Int64 {
+	return Of(value)
+}
+
+// OfInt64Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfInt64Ptr(ptr *int64) Int64 {
+	return OfPtr(ptr)
+}
+
+// EmptyInt64 returns an empty optional.
+func EmptyInt64() Int64 {
+	return Empty[int64]()
+}
+
+// OfSQLNullInt64 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullInt64(n sql.Null[int64]) Int64 {
+	return OfSQLNull(n)
+}
+
+// OfNullableInt64 returns a nullable that is set to the value.
+func OfNullableInt64(value int64) NullableInt64 {
+	return OfNullable(value)
+}
+
+// SetNullableInt64 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableInt64(o Int64) NullableInt64 {
+	return SetNullable(o)
+}
+
+// UnsetNullableInt64 returns a nullable that is not set.
+func UnsetNullableInt64() NullableInt64 {
+	return UnsetNullable[int64]()
+}
+
+// Int8 is an optional int8.
+type Int8 = Optional[int8]
+
+// NullableInt8 is a nullable int8.
+type NullableInt8 = Nullable[int8]
+
+// OfInt8 wraps the value in an optional.
+func OfInt8(value int8) Int8 {
+	return Of(value)
+}
+
+// OfInt8Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfInt8Ptr(ptr *int8) Int8 {
+	return OfPtr(ptr)
+}
+
+// EmptyInt8 returns an empty optional.
+func EmptyInt8() Int8 {
+	return Empty[int8]()
+}
+
+// OfSQLNullInt8 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullInt8(n sql.Null[int8]) Int8 {
+	return OfSQLNull(n)
+}
+
+// OfNullableInt8 returns a nullable that is set to the value.
+func OfNullableInt8(value int8) NullableInt8 {
+	return OfNullable(value)
+}
+
+// SetNullableInt8 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableInt8(o Int8) NullableInt8 {
+	return SetNullable(o)
+}
+
+// UnsetNullableInt8 returns a nullable that is not set.
+func UnsetNullableInt8() NullableInt8 {
+	return UnsetNullable[int8]()
+}
+
+// Rune is an optional rune.
+type Rune = Optional[rune]
+
+// NullableRune is a nullable rune.
+type NullableRune = Nullable[rune]
+
+// OfRune wraps the value in an optional.
+func OfRune(value rune) Rune {
+	return Of(value)
+}
+
+// OfRunePtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfRunePtr(ptr *rune) Rune {
+	return OfPtr(ptr)
+}
+
+// EmptyRune returns an empty optional.
+func EmptyRune() Rune {
+	return Empty[rune]()
+}
+
+// OfSQLNullRune wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullRune(n sql.Null[rune]) Rune {
+	return OfSQLNull(n)
+}
+
+// OfNullableRune returns a nullable that is set to the value.
+func OfNullableRune(value rune) NullableRune {
+	return OfNullable(value)
+}
+
+// SetNullableRune returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableRune(o Rune) NullableRune {
+	return SetNullable(o)
+}
+
+// UnsetNullableRune returns a nullable that is not set.
+func UnsetNullableRune() NullableRune {
+	return UnsetNullable[rune]()
+}
+
+// String is an optional string.
+type String = Optional[string]
+
+// NullableString is a nullable string.
+type NullableString = Nullable[string]
+
+// OfString wraps the value in an optional.
+func OfString(value string) String {
+	return Of(value)
+}
+
+// OfStringPtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfStringPtr(ptr *string) String {
+	return OfPtr(ptr)
+}
+
+// EmptyString returns an empty optional.
+func EmptyString() String {
+	return Empty[string]()
+}
+
+// OfSQLNullString wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullString(n sql.Null[string]) String {
+	return OfSQLNull(n)
+}
+
+// OfNullableString returns a nullable that is set to the value.
+func OfNullableString(value string) NullableString {
+	return OfNullable(value)
+}
+
+// SetNullableString returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableString(o String) NullableString {
+	return SetNullable(o)
+}
+
+// UnsetNullableString returns a nullable that is not set.
+func UnsetNullableString() NullableString {
+	return UnsetNullable[string]()
+}
+
+// Uint is an optional uint.
+type Uint = Optional[uint]
+
+// NullableUint is a nullable uint.
+type NullableUint = Nullable[uint]
+
+// OfUint wraps the value in an optional.
+func OfUint(value uint) Uint {
+	return Of(value)
+}
+
+// OfUintPtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfUintPtr(ptr *uint) Uint {
+	return OfPtr(ptr)
+}
+
+// EmptyUint returns an empty optional.
+func EmptyUint() Uint {
+	return Empty[uint]()
+}
+
+// OfSQLNullUint wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullUint(n sql.Null[uint]) Uint {
+	return OfSQLNull(n)
+}
+
+// OfNullableUint returns a nullable that is set to the value.
+func OfNullableUint(value uint) NullableUint {
+	return OfNullable(value)
+}
+
+// SetNullableUint returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableUint(o Uint) NullableUint {
+	return SetNullable(o)
+}
+
+// UnsetNullableUint returns a nullable that is not set.
+func UnsetNullableUint() NullableUint {
+	return UnsetNullable[uint]()
+}
+
+// Uint16 is an optional uint16.
+type Uint16 = Optional[uint16]
+
+// NullableUint16 is a nullable uint16.
+type NullableUint16 = Nullable[uint16]
+
+// OfUint16 wraps the value in an optional.
+func OfUint16(value uint16) Uint16 {
+	return Of(value)
+}
+
+// OfUint16Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfUint16Ptr(ptr *uint16) Uint16 {
+	return OfPtr(ptr)
+}
+
+// EmptyUint16 returns an empty optional.
+func EmptyUint16() Uint16 {
+	return Empty[uint16]()
+}
+
+// OfSQLNullUint16 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullUint16(n sql.Null[uint16]) Uint16 {
+	return OfSQLNull(n)
+}
+
+// OfNullableUint16 returns a nullable that is set to the value.
+func OfNullableUint16(value uint16) NullableUint16 {
+	return OfNullable(value)
+}
+
+// SetNullableUint16 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableUint16(o Uint16) NullableUint16 {
+	return SetNullable(o)
+}
+
+// UnsetNullableUint16 returns a nullable that is not set.
+func UnsetNullableUint16() NullableUint16 {
+	return UnsetNullable[uint16]()
+}
+
+// Uint32 is an optional uint32.
+type Uint32 = Optional[uint32]
+
+// NullableUint32 is a nullable uint32.
+type NullableUint32 = Nullable[uint32]
+
+// OfUint32 wraps the value in an optional.
+func OfUint32(value uint32) Uint32 {
+	return Of(value)
+}
+
+// OfUint32Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfUint32Ptr(ptr *uint32) Uint32 {
+	return OfPtr(ptr)
+}
+
+// EmptyUint32 returns an empty optional.
+func EmptyUint32() Uint32 {
+	return Empty[uint32]()
+}
+
+// OfSQLNullUint32 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullUint32(n sql.Null[uint32]) Uint32 {
+	return OfSQLNull(n)
+}
+
+// OfNullableUint32 returns a nullable that is set to the value.
+func OfNullableUint32(value uint32) NullableUint32 {
+	return OfNullable(value)
+}
+
+// SetNullableUint32 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableUint32(o Uint32) NullableUint32 {
+	return SetNullable(o)
+}
+
+// UnsetNullableUint32 returns a nullable that is not set.
+func UnsetNullableUint32() NullableUint32 {
+	return UnsetNullable[uint32]()
+}
+
+// Uint64 is an optional uint64.
+type Uint64 = Optional[uint64]
+
+// NullableUint64 is a nullable uint64.
+type NullableUint64 = Nullable[uint64]
+
+// OfUint64 wraps the value in an optional.
+func OfUint64(value uint64) Uint64 {
+	return Of(value)
+}
+
+// OfUint64Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfUint64Ptr(ptr *uint64) Uint64 {
+	return OfPtr(ptr)
+}
+
+// EmptyUint64 returns an empty optional.
+func EmptyUint64() Uint64 {
+	return Empty[uint64]()
+}
+
+// OfSQLNullUint64 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullUint64(n sql.Null[uint64]) Uint64 {
+	return OfSQLNull(n)
+}
+
+// OfNullableUint64 returns a nullable that is set to the value.
+func OfNullableUint64(value uint64) NullableUint64 {
+	return OfNullable(value)
+}
+
+// SetNullableUint64 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableUint64(o Uint64) NullableUint64 {
+	return SetNullable(o)
+}
+
+// UnsetNullableUint64 returns a nullable that is not set.
+func UnsetNullableUint64() NullableUint64 {
+	return UnsetNullable[uint64]()
+}
+
+// Uint8 is an optional uint8.
+type Uint8 = Optional[uint8]
+
+// NullableUint8 is a nullable uint8.
+type NullableUint8 = Nullable[uint8]
+
+// OfUint8 wraps the value in an optional.
+func OfUint8(value uint8) Uint8 {
+	return Of(value)
+}
+
+// OfUint8Ptr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfUint8Ptr(ptr *uint8) Uint8 {
+	return OfPtr(ptr)
+}
+
+// EmptyUint8 returns an empty optional.
+func EmptyUint8() Uint8 {
+	return Empty[uint8]()
+}
+
+// OfSQLNullUint8 wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullUint8(n sql.Null[uint8]) Uint8 {
+	return OfSQLNull(n)
+}
+
+// OfNullableUint8 returns a nullable that is set to the value.
+func OfNullableUint8(value uint8) NullableUint8 {
+	return OfNullable(value)
+}
+
+// SetNullableUint8 returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableUint8(o Uint8) NullableUint8 {
+	return SetNullable(o)
+}
+
+// UnsetNullableUint8 returns a nullable that is not set.
+func UnsetNullableUint8() NullableUint8 {
+	return UnsetNullable[uint8]()
+}
+
+// Uintptr is an optional uintptr.
+type Uintptr = Optional[uintptr]
+
+// NullableUintptr is a nullable uintptr.
+type NullableUintptr = Nullable[uintptr]
+
+// OfUintptr wraps the value in an optional.
+func OfUintptr(value uintptr) Uintptr {
+	return Of(value)
+}
+
+// OfUintptrPtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfUintptrPtr(ptr *uintptr) Uintptr {
+	return OfPtr(ptr)
+}
+
+// EmptyUintptr returns an empty optional.
+func EmptyUintptr() Uintptr {
+	return Empty[uintptr]()
+}
+
+// OfSQLNullUintptr wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullUintptr(n sql.Null[uintptr]) Uintptr {
+	return OfSQLNull(n)
+}
+
+// OfNullableUintptr returns a nullable that is set to the value.
+func OfNullableUintptr(value uintptr) NullableUintptr {
+	return OfNullable(value)
+}
+
+// SetNullableUintptr returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableUintptr(o Uintptr) NullableUintptr {
+	return SetNullable(o)
+}
+
+// UnsetNullableUintptr returns a nullable that is not set.
+func UnsetNullableUintptr() NullableUintptr {
+	return UnsetNullable[uintptr]()
+}
+
+// Time is an optional time.Time.
+type Time = Optional[time.Time]
+
+// NullableTime is a nullable time.Time.
+type NullableTime = Nullable[time.Time]
+
+// OfTime wraps the value in an optional.
+func OfTime(value time.Time) Time {
+	return Of(value)
+}
+
+// OfTimePtr wraps the value the pointer points to in an optional, or returns
+// an empty optional if the pointer is nil.
+func OfTimePtr(ptr *time.Time) Time {
+	return OfPtr(ptr)
+}
+
+// EmptyTime returns an empty optional.
+func EmptyTime() Time {
+	return Empty[time.Time]()
+}
+
+// OfSQLNullTime wraps the value of the sql.Null in an optional, or returns an
+// empty optional if the sql.Null is not valid.
+func OfSQLNullTime(n sql.Null[time.Time]) Time {
+	return OfSQLNull(n)
+}
+
+// OfNullableTime returns a nullable that is set to the value.
+func OfNullableTime(value time.Time) NullableTime {
+	return OfNullable(value)
+}
+
+// SetNullableTime returns a nullable that is set to the optional. The
+// nullable is null if the optional is empty.
+func SetNullableTime(o Time) NullableTime {
+	return SetNullable(o)
+}
+
+// UnsetNullableTime returns a nullable that is not set.
+func UnsetNullableTime() NullableTime {
+	return UnsetNullable[time.Time]()
+}
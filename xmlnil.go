@@ -0,0 +1,21 @@
+package optional
+
+import "encoding/xml"
+
+// XMLNil wraps an optional and marshals it to XML as an element with the
+// attribute xsi:nil="true" when it is empty, instead of not marshaling an
+// element. XMLNil otherwise marshals and unmarshals the same as the optional
+// it wraps, which unmarshals elements with the attribute xsi:nil="true" into
+// empty optionals.
+type XMLNil[T any] struct {
+	Optional[T]
+}
+
+// MarshalXML marshals the value being wrapped to XML. If there is no value
+// being wrapped, an element with the attribute xsi:nil="true" is marshaled.
+func (o XMLNil[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.IsPresent() {
+		return encodeXSINil(e, start)
+	}
+	return o.Optional.MarshalXML(e, start)
+}
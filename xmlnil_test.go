@@ -0,0 +1,34 @@
+package optional
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestXMLNil(t *testing.T) {
+	type s struct {
+		XMLName xml.Name    `xml:"s"`
+		Int     XMLNil[int] `xml:"i"`
+		Plain   Int         `xml:"p"`
+	}
+	tests := []struct {
+		Value       s
+		ExpectedXML string
+	}{
+		{s{}, `<s><i xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></i></s>`},
+		{s{Int: XMLNil[int]{OfInt(0)}, Plain: OfInt(1)}, `<s><i>0</i><p>1</p></s>`},
+	}
+
+	for _, test := range tests {
+		x, err := xml.Marshal(test.Value)
+		if err != nil || string(x) != test.ExpectedXML {
+			t.Errorf("%#v xml.Marshal got %s, %v, want %s", test.Value, x, err, test.ExpectedXML)
+		}
+		var fromXML s
+		err = xml.Unmarshal(x, &fromXML)
+		fromXML.XMLName = xml.Name{}
+		if err != nil || fromXML != test.Value {
+			t.Errorf("%s xml.Unmarshal got %#v, %v, want %#v", x, fromXML, err, test.Value)
+		}
+	}
+}